@@ -1,7 +1,6 @@
 package cast
 
 import (
-	"fmt"
 	"reflect"
 	"strconv"
 )
@@ -17,13 +16,41 @@ type BoolSliceProvider interface {
 }
 
 // ToBool converts an interface to a bool. Returns an error if the conversion is not possible.
-func ToBool(value interface{}) (bool, error) {
-	value = indirect(value)
+func ToBool(value interface{}, opts ...Option) (bool, error) {
+	return toBool(value, newOptions(opts))
+}
+
+func toBool(value interface{}, o *options) (bool, error) {
+	v, err := convertBool(value, o)
+	if err == nil && o.validators != nil {
+		err = o.validate(v, value, "bool")
+	}
+	if err != nil {
+		return false, err
+	}
+	return v, nil
+}
+
+func convertBool(value interface{}, o *options) (bool, error) {
+	value, err := indirect(value, o)
+	if err != nil {
+		return false, newCastError("bool", value, o, err)
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[bool](value, "bool", o); ok {
+		return v, err
+	}
+
 	switch val := value.(type) {
 	case nil:
-		return false, newNilError("bool")
+		return nilResult[bool](o, "bool")
 	case BoolProvider:
-		return val.Bool()
+		v, err := val.Bool()
+		if err != nil {
+			return false, newCastError("bool", value, o, err)
+		}
+		return v, nil
 	case bool:
 		return val, nil
 	case int, int8, int16, int32, int64:
@@ -35,50 +62,116 @@ func ToBool(value interface{}) (bool, error) {
 	case string:
 		v, err := strconv.ParseBool(val)
 		if err != nil {
-			return false, newTypeError("bool")
+			return false, newSyntaxError("bool", value, o)
 		}
 		return v, nil
 	default:
-		v, err := strconv.ParseBool(fmt.Sprintf("%v", value))
-		if err != nil {
-			return false, newTypeError("bool")
+		if u, ok := underlying(val); ok {
+			return convertBool(u, o)
 		}
-		return v, nil
+
+		if s, ok := textOf(value); ok {
+			if v, err := strconv.ParseBool(s); err == nil {
+				return v, nil
+			}
+		}
+		return false, newUnsupportedError("bool", value, o)
+	}
+}
+
+// ToBoolOr converts an interface like ToBool, returning fallback on error.
+func ToBoolOr(value interface{}, fallback bool, opts ...Option) bool {
+	if v, err := ToBool(value, opts...); err == nil {
+		return v
 	}
+	return fallback
+}
+
+// MustBool converts an interface like ToBool, panicking with the error on failure.
+func MustBool(value interface{}, opts ...Option) bool {
+	return must(ToBool(value, opts...))
 }
 
 // ToBoolSlice converts an interface to a slice of bool. Returns an error if the conversion is not possible.
-func ToBoolSlice(value interface{}) ([]bool, error) {
-	value = indirect(value)
+// A []bool value is returned as is and shares its backing array; use WithCopy to get a copy.
+func ToBoolSlice(value interface{}, opts ...Option) ([]bool, error) {
+	return toBoolSlice(value, newOptions(opts))
+}
+
+func toBoolSlice(value interface{}, o *options) ([]bool, error) {
+	o.resetReport()
+	if v, ok := value.([]bool); ok && v != nil && !o.copy && o.validators == nil {
+		return v, nil
+	}
+	return appendBool(nil, value, o)
+}
+
+// ToBoolSliceOr converts an interface like ToBoolSlice, returning fallback on error.
+func ToBoolSliceOr(value interface{}, fallback []bool, opts ...Option) []bool {
+	if v, err := ToBoolSlice(value, opts...); err == nil {
+		return v
+	}
+	return fallback
+}
+
+// MustBoolSlice converts an interface like ToBoolSlice, panicking with the error on failure.
+func MustBoolSlice(value interface{}, opts ...Option) []bool {
+	return must(ToBoolSlice(value, opts...))
+}
+
+// AppendBool converts an interface like ToBoolSlice and appends the elements to dst.
+// On failure dst is returned unchanged.
+func AppendBool(dst []bool, value interface{}, opts ...Option) ([]bool, error) {
+	return appendBool(dst, value, newOptions(opts))
+}
+
+func appendBool(dst []bool, value interface{}, o *options) ([]bool, error) {
+	o.resetReport()
+	value, err := indirect(value, o)
+	if err != nil {
+		return dst, newCastError("[]bool", value, o, err)
+	}
+
+	// Handle validated conversions element by element
+	if o.validators != nil {
+		return validateSlice(dst, value, "[]bool", o, toBool)
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[[]bool](value, "[]bool", o); ok {
+		if err != nil {
+			return dst, err
+		}
+		return append(grow(dst, len(v)), v...), nil
+	}
+
 	switch v := value.(type) {
 	case nil:
-		return nil, newNilError("[]bool")
+		_, err := nilResult[[]bool](o, "[]bool")
+		return dst, err
 	case BoolSliceProvider:
-		return v.BoolSlice()
+		res, err := v.BoolSlice()
+		if err != nil {
+			return dst, newCastError("[]bool", value, o, err)
+		}
+		return append(grow(dst, len(res)), res...), nil
 	case []bool:
-		return v, nil
+		return append(grow(dst, len(v)), v...), nil
 	}
 
-	// Handle slices or arrays of values
-	switch reflect.TypeOf(value).Kind() {
-	case reflect.Slice, reflect.Array:
-		arr := reflect.ValueOf(value)
-		res := make([]bool, 0, arr.Len())
-		for i := 0; i < arr.Len(); i++ {
-			b, err := ToBool(arr.Index(i).Interface())
-			if err != nil {
-				if IsNilError(err) {
-					return nil, newNilError("[]bool")
-				} else if IsCastError(err) {
-					return nil, newTypeError("[]bool")
-				} else {
-					return nil, fmt.Errorf("[]bool: %w", err)
-				}
-			}
-			res = append(res, b)
+	// Handle the providers of other slices
+	if s, ok, err := provideSlice(value); ok {
+		if err != nil {
+			return dst, newCastError("[]bool", value, o, err)
 		}
+		value = s
+	}
+
+	// Handle common slices without reflection
+	if res, ok := fastSlice(dst, value, o, boolElements); ok {
 		return res, nil
 	}
 
-	return nil, newTypeError("[]bool")
+	// Handle slices or arrays of values
+	return convertSlice(dst, value, "[]bool", o, toBool)
 }
@@ -61,3 +61,25 @@ func TestToBoolSlice(t *testing.T) {
 		}
 	}
 }
+
+type toggle bool
+
+func TestToBoolNamed(t *testing.T) {
+	result, err := cast.ToBool(toggle(true))
+	assert.NoError(t, err)
+	assert.Equal(t, true, result)
+
+	result, err = cast.ToBool(stringerLevel(1))
+	assert.NoError(t, err)
+	assert.Equal(t, true, result)
+}
+
+func TestAppendBool(t *testing.T) {
+	result, err := cast.AppendBool([]bool{true}, []string{"false", "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, result)
+
+	failed, err := cast.AppendBool(result, []string{"yes"})
+	assert.Error(t, err)
+	assert.Equal(t, result, failed)
+}
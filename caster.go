@@ -1,8 +1,16 @@
 package cast
 
+import (
+	"sync"
+)
+
 // Caster provides methods for type casting and conversion.
+// The With methods take options, such as validators, which are applied after the
+// options of the caster. Safe methods return the fallback if validation fails.
+// The conversion methods without options implement the provider interfaces, such as
+// IntProvider, so a Caster can be passed to the conversion functions.
 type Caster interface {
-	// IsNil checks if the value is nil.
+	// IsNil checks if the value is nil, including typed nils such as nil pointers, maps and slices.
 	IsNil() bool
 
 	// Interface returns the value as an interface{}.
@@ -11,182 +19,381 @@ type Caster interface {
 	// Slice converts the value to a slice of interface{}.
 	Slice() ([]interface{}, error)
 
+	// SliceWith converts the value like Slice, applying opts after the options of the caster.
+	SliceWith(opts ...Option) ([]interface{}, error)
+
 	// SliceSafe converts the value to a slice of interface{}, with a fallback on error.
 	SliceSafe(fallback []interface{}) []interface{}
 
+	// MustSlice converts the value to a slice of interface{}, panicking on error.
+	MustSlice() []interface{}
+
 	// Unmarshal decodes the value into the provided output using JSON.
 	Unmarshal(out interface{}) error
 
 	// Bool converts the value to a bool.
 	Bool() (bool, error)
 
+	// BoolWith converts the value like Bool, applying opts after the options of the caster.
+	BoolWith(opts ...Option) (bool, error)
+
 	// BoolSafe converts the value to a bool, with a fallback on error.
 	BoolSafe(fallback bool) bool
 
+	// MustBool converts the value to a bool, panicking on error.
+	MustBool() bool
+
 	// BoolSlice converts the value to a slice of bool.
 	BoolSlice() ([]bool, error)
 
+	// BoolSliceWith converts the value like BoolSlice, applying opts after the options of the caster.
+	BoolSliceWith(opts ...Option) ([]bool, error)
+
 	// BoolSliceSafe converts the value to a slice of bool, with a fallback on error.
 	BoolSliceSafe(fallback []bool) []bool
 
+	// MustBoolSlice converts the value to a slice of bool, panicking on error.
+	MustBoolSlice() []bool
+
 	// Int converts the value to an int.
 	Int() (int, error)
 
+	// IntWith converts the value like Int, applying opts after the options of the caster.
+	IntWith(opts ...Option) (int, error)
+
 	// IntSafe converts the value to an int, with a fallback on error.
 	IntSafe(fallback int) int
 
+	// MustInt converts the value to an int, panicking on error.
+	MustInt() int
+
 	// IntSlice converts the value to a slice of int.
 	IntSlice() ([]int, error)
 
+	// IntSliceWith converts the value like IntSlice, applying opts after the options of the caster.
+	IntSliceWith(opts ...Option) ([]int, error)
+
 	// IntSliceSafe converts the value to a slice of int, with a fallback on error.
 	IntSliceSafe(fallback []int) []int
 
+	// MustIntSlice converts the value to a slice of int, panicking on error.
+	MustIntSlice() []int
+
 	// Int8 converts the value to an int8.
 	Int8() (int8, error)
 
+	// Int8With converts the value like Int8, applying opts after the options of the caster.
+	Int8With(opts ...Option) (int8, error)
+
 	// Int8Safe converts the value to an int8, with a fallback on error.
 	Int8Safe(fallback int8) int8
 
+	// MustInt8 converts the value to an int8, panicking on error.
+	MustInt8() int8
+
 	// Int8Slice converts the value to a slice of int8.
 	Int8Slice() ([]int8, error)
 
+	// Int8SliceWith converts the value like Int8Slice, applying opts after the options of the caster.
+	Int8SliceWith(opts ...Option) ([]int8, error)
+
 	// Int8SliceSafe converts the value to a slice of int8, with a fallback on error.
 	Int8SliceSafe(fallback []int8) []int8
 
+	// MustInt8Slice converts the value to a slice of int8, panicking on error.
+	MustInt8Slice() []int8
+
 	// Int16 converts the value to an int16.
 	Int16() (int16, error)
 
+	// Int16With converts the value like Int16, applying opts after the options of the caster.
+	Int16With(opts ...Option) (int16, error)
+
 	// Int16Safe converts the value to an int16, with a fallback on error.
 	Int16Safe(fallback int16) int16
 
+	// MustInt16 converts the value to an int16, panicking on error.
+	MustInt16() int16
+
 	// Int16Slice converts the value to a slice of int16.
 	Int16Slice() ([]int16, error)
 
+	// Int16SliceWith converts the value like Int16Slice, applying opts after the options of the caster.
+	Int16SliceWith(opts ...Option) ([]int16, error)
+
 	// Int16SliceSafe converts the value to a slice of int16, with a fallback on error.
 	Int16SliceSafe(fallback []int16) []int16
 
+	// MustInt16Slice converts the value to a slice of int16, panicking on error.
+	MustInt16Slice() []int16
+
 	// Int32 converts the value to an int32.
 	Int32() (int32, error)
 
+	// Int32With converts the value like Int32, applying opts after the options of the caster.
+	Int32With(opts ...Option) (int32, error)
+
 	// Int32Safe converts the value to an int32, with a fallback on error.
 	Int32Safe(fallback int32) int32
 
+	// MustInt32 converts the value to an int32, panicking on error.
+	MustInt32() int32
+
 	// Int32Slice converts the value to a slice of int32.
 	Int32Slice() ([]int32, error)
 
+	// Int32SliceWith converts the value like Int32Slice, applying opts after the options of the caster.
+	Int32SliceWith(opts ...Option) ([]int32, error)
+
 	// Int32SliceSafe converts the value to a slice of int32, with a fallback on error.
 	Int32SliceSafe(fallback []int32) []int32
 
+	// MustInt32Slice converts the value to a slice of int32, panicking on error.
+	MustInt32Slice() []int32
+
 	// Int64 converts the value to an int64.
 	Int64() (int64, error)
 
+	// Int64With converts the value like Int64, applying opts after the options of the caster.
+	Int64With(opts ...Option) (int64, error)
+
 	// Int64Safe converts the value to an int64, with a fallback on error.
 	Int64Safe(fallback int64) int64
 
+	// MustInt64 converts the value to an int64, panicking on error.
+	MustInt64() int64
+
 	// Int64Slice converts the value to a slice of int64.
 	Int64Slice() ([]int64, error)
 
+	// Int64SliceWith converts the value like Int64Slice, applying opts after the options of the caster.
+	Int64SliceWith(opts ...Option) ([]int64, error)
+
 	// Int64SliceSafe converts the value to a slice of int64, with a fallback on error.
 	Int64SliceSafe(fallback []int64) []int64
 
+	// MustInt64Slice converts the value to a slice of int64, panicking on error.
+	MustInt64Slice() []int64
+
 	// Uint converts the value to a uint.
 	Uint() (uint, error)
 
+	// UintWith converts the value like Uint, applying opts after the options of the caster.
+	UintWith(opts ...Option) (uint, error)
+
 	// UintSafe converts the value to a uint, with a fallback on error.
 	UintSafe(fallback uint) uint
 
+	// MustUint converts the value to a uint, panicking on error.
+	MustUint() uint
+
 	// UintSlice converts the value to a slice of uint.
 	UintSlice() ([]uint, error)
 
+	// UintSliceWith converts the value like UintSlice, applying opts after the options of the caster.
+	UintSliceWith(opts ...Option) ([]uint, error)
+
 	// UintSliceSafe converts the value to a slice of uint, with a fallback on error.
 	UintSliceSafe(fallback []uint) []uint
 
+	// MustUintSlice converts the value to a slice of uint, panicking on error.
+	MustUintSlice() []uint
+
 	// Uint8 converts the value to a uint8.
 	Uint8() (uint8, error)
 
+	// Uint8With converts the value like Uint8, applying opts after the options of the caster.
+	Uint8With(opts ...Option) (uint8, error)
+
 	// Uint8Safe converts the value to a uint8, with a fallback on error.
 	Uint8Safe(fallback uint8) uint8
 
+	// MustUint8 converts the value to a uint8, panicking on error.
+	MustUint8() uint8
+
 	// Uint8Slice converts the value to a slice of uint8.
 	Uint8Slice() ([]uint8, error)
 
+	// Uint8SliceWith converts the value like Uint8Slice, applying opts after the options of the caster.
+	Uint8SliceWith(opts ...Option) ([]uint8, error)
+
 	// Uint8SliceSafe converts the value to a slice of uint8, with a fallback on error.
 	Uint8SliceSafe(fallback []uint8) []uint8
 
+	// MustUint8Slice converts the value to a slice of uint8, panicking on error.
+	MustUint8Slice() []uint8
+
 	// Uint16 converts the value to a uint16.
 	Uint16() (uint16, error)
 
+	// Uint16With converts the value like Uint16, applying opts after the options of the caster.
+	Uint16With(opts ...Option) (uint16, error)
+
 	// Uint16Safe converts the value to a uint16, with a fallback on error.
 	Uint16Safe(fallback uint16) uint16
 
+	// MustUint16 converts the value to a uint16, panicking on error.
+	MustUint16() uint16
+
 	// Uint16Slice converts the value to a slice of uint16.
 	Uint16Slice() ([]uint16, error)
 
+	// Uint16SliceWith converts the value like Uint16Slice, applying opts after the options of the caster.
+	Uint16SliceWith(opts ...Option) ([]uint16, error)
+
 	// Uint16SliceSafe converts the value to a slice of uint16, with a fallback on error.
 	Uint16SliceSafe(fallback []uint16) []uint16
 
+	// MustUint16Slice converts the value to a slice of uint16, panicking on error.
+	MustUint16Slice() []uint16
+
 	// Uint32 converts the value to a uint32.
 	Uint32() (uint32, error)
 
+	// Uint32With converts the value like Uint32, applying opts after the options of the caster.
+	Uint32With(opts ...Option) (uint32, error)
+
 	// Uint32Safe converts the value to a uint32, with a fallback on error.
 	Uint32Safe(fallback uint32) uint32
 
+	// MustUint32 converts the value to a uint32, panicking on error.
+	MustUint32() uint32
+
 	// Uint32Slice converts the value to a slice of uint32.
 	Uint32Slice() ([]uint32, error)
 
+	// Uint32SliceWith converts the value like Uint32Slice, applying opts after the options of the caster.
+	Uint32SliceWith(opts ...Option) ([]uint32, error)
+
 	// Uint32SliceSafe converts the value to a slice of uint32, with a fallback on error.
 	Uint32SliceSafe(fallback []uint32) []uint32
 
+	// MustUint32Slice converts the value to a slice of uint32, panicking on error.
+	MustUint32Slice() []uint32
+
 	// Uint64 converts the value to a uint64.
 	Uint64() (uint64, error)
 
+	// Uint64With converts the value like Uint64, applying opts after the options of the caster.
+	Uint64With(opts ...Option) (uint64, error)
+
 	// Uint64Safe converts the value to a uint64, with a fallback on error.
 	Uint64Safe(fallback uint64) uint64
 
+	// MustUint64 converts the value to a uint64, panicking on error.
+	MustUint64() uint64
+
 	// Uint64Slice converts the value to a slice of uint64.
 	Uint64Slice() ([]uint64, error)
 
+	// Uint64SliceWith converts the value like Uint64Slice, applying opts after the options of the caster.
+	Uint64SliceWith(opts ...Option) ([]uint64, error)
+
 	// Uint64SliceSafe converts the value to a slice of uint64, with a fallback on error.
 	Uint64SliceSafe(fallback []uint64) []uint64
 
+	// MustUint64Slice converts the value to a slice of uint64, panicking on error.
+	MustUint64Slice() []uint64
+
 	// Float32 converts the value to a float32.
 	Float32() (float32, error)
 
+	// Float32With converts the value like Float32, applying opts after the options of the caster.
+	Float32With(opts ...Option) (float32, error)
+
 	// Float32Safe converts the value to a float32, with a fallback on error.
 	Float32Safe(fallback float32) float32
 
+	// MustFloat32 converts the value to a float32, panicking on error.
+	MustFloat32() float32
+
 	// Float32Slice converts the value to a slice of float32.
 	Float32Slice() ([]float32, error)
 
+	// Float32SliceWith converts the value like Float32Slice, applying opts after the options of the caster.
+	Float32SliceWith(opts ...Option) ([]float32, error)
+
 	// Float32SliceSafe converts the value to a slice of float32, with a fallback on error.
 	Float32SliceSafe(fallback []float32) []float32
 
+	// MustFloat32Slice converts the value to a slice of float32, panicking on error.
+	MustFloat32Slice() []float32
+
 	// Float64 converts the value to a float64.
 	Float64() (float64, error)
 
+	// Float64With converts the value like Float64, applying opts after the options of the caster.
+	Float64With(opts ...Option) (float64, error)
+
 	// Float64Safe converts the value to a float64, with a fallback on error.
 	Float64Safe(fallback float64) float64
 
+	// MustFloat64 converts the value to a float64, panicking on error.
+	MustFloat64() float64
+
 	// Float64Slice converts the value to a slice of float64.
 	Float64Slice() ([]float64, error)
 
+	// Float64SliceWith converts the value like Float64Slice, applying opts after the options of the caster.
+	Float64SliceWith(opts ...Option) ([]float64, error)
+
 	// Float64SliceSafe converts the value to a slice of float64, with a fallback on error.
 	Float64SliceSafe(fallback []float64) []float64
 
+	// MustFloat64Slice converts the value to a slice of float64, panicking on error.
+	MustFloat64Slice() []float64
+
 	// String converts the value to a string.
 	String() (string, error)
 
+	// StringWith converts the value like String, applying opts after the options of the caster.
+	StringWith(opts ...Option) (string, error)
+
 	// StringSafe converts the value to a string, with a fallback on error.
 	StringSafe(fallback string) string
 
+	// MustString converts the value to a string, panicking on error.
+	MustString() string
+
 	// StringSlice converts the value to a slice of string.
 	StringSlice() ([]string, error)
 
+	// StringSliceWith converts the value like StringSlice, applying opts after the options of the caster.
+	StringSliceWith(opts ...Option) ([]string, error)
+
 	// StringSliceSafe converts the value to a slice of string, with a fallback on error.
 	StringSliceSafe(fallback []string) []string
+
+	// MustStringSlice converts the value to a slice of string, panicking on error.
+	MustStringSlice() []string
 }
 
 // NewCaster creates a new Caster instance.
-func NewCaster(v interface{}) Caster {
-	return &caster{v: indirect(v)}
+// The given options are applied to every conversion of the caster.
+// Slice results may share the backing array of v; use WithCopy when they are modified
+// while v is shared, e.g. between goroutines.
+func NewCaster(v interface{}, opts ...Option) Caster {
+	o := newOptions(opts)
+	if resolved, err := indirect(v, o); err == nil {
+		v = resolved
+	}
+	return &caster{v: v, o: o}
+}
+
+// NewCachedCaster creates a Caster like NewCaster that converts its value once per
+// target type and caches the result, including errors. It is safe for concurrent use.
+// Slice results are copied on every call, so they can be modified by the caller.
+// Conversions of the With methods are not cached.
+// A report given with WithReport is ignored, as cached conversions would share it and
+// only fill it once; pass it to the With methods instead.
+// The value must not be modified after the caster is created.
+func NewCachedCaster(v interface{}, opts ...Option) Caster {
+	c := NewCaster(v, opts...).(*caster)
+	if c.o.report != nil {
+		o := *c.o
+		o.report = nil
+		c.o = &o
+	}
+	c.cache = &sync.Map{}
+	return c
 }
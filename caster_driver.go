@@ -3,10 +3,48 @@ package cast
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"slices"
+	"sync"
 )
 
 type caster struct {
-	v interface{}
+	v     interface{}
+	o     *options
+	cache *sync.Map // reflect.Type -> cachedResult, nil if results are not cached
+}
+
+// cachedResult holds the result of a conversion of a cached caster.
+type cachedResult[T any] struct {
+	v   T
+	err error
+}
+
+// casterValue converts the value of a caster with conv, using the cache of the caster if any.
+// Conversions with additional options are not cached.
+func casterValue[T any](c caster, conv func(interface{}, *options) (T, error), opts []Option) (T, error) {
+	if c.cache == nil || len(opts) > 0 {
+		return conv(c.v, c.o.with(opts))
+	}
+
+	key := reflect.TypeFor[T]()
+	if r, ok := c.cache.Load(key); ok {
+		r := r.(cachedResult[T])
+		return r.v, r.err
+	}
+	v, err := conv(c.v, c.o)
+	c.cache.Store(key, cachedResult[T]{v, err})
+	return v, err
+}
+
+// casterSlice converts the value of a caster to a slice like casterValue.
+// Cached slices are copied, so callers never share them.
+func casterSlice[T any](c caster, conv func(interface{}, *options) ([]T, error), opts []Option) ([]T, error) {
+	v, err := casterValue(c, conv, opts)
+	if c.cache != nil && len(opts) == 0 {
+		v = slices.Clone(v)
+	}
+	return v, err
 }
 
 func (c caster) IsNil() bool {
@@ -18,17 +56,25 @@ func (c caster) Interface() interface{} {
 }
 
 func (c caster) Slice() ([]interface{}, error) {
-	return ToSlice(c.v)
+	return casterSlice(c, toSlice, nil)
+}
+
+func (c caster) SliceWith(opts ...Option) ([]interface{}, error) {
+	return casterSlice(c, toSlice, opts)
 }
 
 func (c caster) SliceSafe(f []interface{}) []interface{} {
-	if v, err := ToSlice(c.v); err == nil {
+	if v, err := casterSlice(c, toSlice, nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustSlice() []interface{} {
+	return must(c.Slice())
+}
+
 func (c caster) Unmarshal(out interface{}) error {
 	// Try direct unmarshal
 	err := json.Unmarshal([]byte(fmt.Sprintf("%v", c.v)), out)
@@ -45,336 +91,560 @@ func (c caster) Unmarshal(out interface{}) error {
 }
 
 func (c caster) Bool() (bool, error) {
-	return ToBool(c.v)
+	return casterValue(c, toBool, nil)
+}
+
+func (c caster) BoolWith(opts ...Option) (bool, error) {
+	return casterValue(c, toBool, opts)
 }
 
 func (c caster) BoolSafe(f bool) bool {
-	if v, err := ToBool(c.v); err == nil {
+	if v, err := casterValue(c, toBool, nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustBool() bool {
+	return must(c.Bool())
+}
+
 func (c caster) BoolSlice() ([]bool, error) {
-	return ToBoolSlice(c.v)
+	return casterSlice(c, toBoolSlice, nil)
+}
+
+func (c caster) BoolSliceWith(opts ...Option) ([]bool, error) {
+	return casterSlice(c, toBoolSlice, opts)
 }
 
 func (c caster) BoolSliceSafe(f []bool) []bool {
-	if v, err := ToBoolSlice(c.v); err == nil {
+	if v, err := casterSlice(c, toBoolSlice, nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustBoolSlice() []bool {
+	return must(c.BoolSlice())
+}
+
 func (c caster) Int() (int, error) {
-	return ToSigned[int](c.v)
+	return casterValue(c, toSigned[int], nil)
+}
+
+func (c caster) IntWith(opts ...Option) (int, error) {
+	return casterValue(c, toSigned[int], opts)
 }
 
 func (c caster) IntSafe(f int) int {
-	if v, err := ToSigned[int](c.v); err == nil {
+	if v, err := casterValue(c, toSigned[int], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt() int {
+	return must(c.Int())
+}
+
 func (c caster) IntSlice() ([]int, error) {
-	return ToSignedSlice[int](c.v)
+	return casterSlice(c, toSignedSlice[int], nil)
+}
+
+func (c caster) IntSliceWith(opts ...Option) ([]int, error) {
+	return casterSlice(c, toSignedSlice[int], opts)
 }
 
 func (c caster) IntSliceSafe(f []int) []int {
-	if v, err := ToSignedSlice[int](c.v); err == nil {
+	if v, err := casterSlice(c, toSignedSlice[int], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustIntSlice() []int {
+	return must(c.IntSlice())
+}
+
 func (c caster) Int8() (int8, error) {
-	return ToSigned[int8](c.v)
+	return casterValue(c, toSigned[int8], nil)
+}
+
+func (c caster) Int8With(opts ...Option) (int8, error) {
+	return casterValue(c, toSigned[int8], opts)
 }
 
 func (c caster) Int8Safe(f int8) int8 {
-	if v, err := ToSigned[int8](c.v); err == nil {
+	if v, err := casterValue(c, toSigned[int8], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt8() int8 {
+	return must(c.Int8())
+}
+
 func (c caster) Int8Slice() ([]int8, error) {
-	return ToSignedSlice[int8](c.v)
+	return casterSlice(c, toSignedSlice[int8], nil)
+}
+
+func (c caster) Int8SliceWith(opts ...Option) ([]int8, error) {
+	return casterSlice(c, toSignedSlice[int8], opts)
 }
 
 func (c caster) Int8SliceSafe(f []int8) []int8 {
-	if v, err := ToSignedSlice[int8](c.v); err == nil {
+	if v, err := casterSlice(c, toSignedSlice[int8], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt8Slice() []int8 {
+	return must(c.Int8Slice())
+}
+
 func (c caster) Int16() (int16, error) {
-	return ToSigned[int16](c.v)
+	return casterValue(c, toSigned[int16], nil)
+}
+
+func (c caster) Int16With(opts ...Option) (int16, error) {
+	return casterValue(c, toSigned[int16], opts)
 }
 
 func (c caster) Int16Safe(f int16) int16 {
-	if v, err := ToSigned[int16](c.v); err == nil {
+	if v, err := casterValue(c, toSigned[int16], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt16() int16 {
+	return must(c.Int16())
+}
+
 func (c caster) Int16Slice() ([]int16, error) {
-	return ToSignedSlice[int16](c.v)
+	return casterSlice(c, toSignedSlice[int16], nil)
+}
+
+func (c caster) Int16SliceWith(opts ...Option) ([]int16, error) {
+	return casterSlice(c, toSignedSlice[int16], opts)
 }
 
 func (c caster) Int16SliceSafe(f []int16) []int16 {
-	if v, err := ToSignedSlice[int16](c.v); err == nil {
+	if v, err := casterSlice(c, toSignedSlice[int16], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt16Slice() []int16 {
+	return must(c.Int16Slice())
+}
+
 func (c caster) Int32() (int32, error) {
-	return ToSigned[int32](c.v)
+	return casterValue(c, toSigned[int32], nil)
+}
+
+func (c caster) Int32With(opts ...Option) (int32, error) {
+	return casterValue(c, toSigned[int32], opts)
 }
 
 func (c caster) Int32Safe(f int32) int32 {
-	if v, err := ToSigned[int32](c.v); err == nil {
+	if v, err := casterValue(c, toSigned[int32], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt32() int32 {
+	return must(c.Int32())
+}
+
 func (c caster) Int32Slice() ([]int32, error) {
-	return ToSignedSlice[int32](c.v)
+	return casterSlice(c, toSignedSlice[int32], nil)
+}
+
+func (c caster) Int32SliceWith(opts ...Option) ([]int32, error) {
+	return casterSlice(c, toSignedSlice[int32], opts)
 }
 
 func (c caster) Int32SliceSafe(f []int32) []int32 {
-	if v, err := ToSignedSlice[int32](c.v); err == nil {
+	if v, err := casterSlice(c, toSignedSlice[int32], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt32Slice() []int32 {
+	return must(c.Int32Slice())
+}
+
 func (c caster) Int64() (int64, error) {
-	return ToSigned[int64](c.v)
+	return casterValue(c, toSigned[int64], nil)
+}
+
+func (c caster) Int64With(opts ...Option) (int64, error) {
+	return casterValue(c, toSigned[int64], opts)
 }
 
 func (c caster) Int64Safe(f int64) int64 {
-	if v, err := ToSigned[int64](c.v); err == nil {
+	if v, err := casterValue(c, toSigned[int64], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt64() int64 {
+	return must(c.Int64())
+}
+
 func (c caster) Int64Slice() ([]int64, error) {
-	return ToSignedSlice[int64](c.v)
+	return casterSlice(c, toSignedSlice[int64], nil)
+}
+
+func (c caster) Int64SliceWith(opts ...Option) ([]int64, error) {
+	return casterSlice(c, toSignedSlice[int64], opts)
 }
 
 func (c caster) Int64SliceSafe(f []int64) []int64 {
-	if v, err := ToSignedSlice[int64](c.v); err == nil {
+	if v, err := casterSlice(c, toSignedSlice[int64], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustInt64Slice() []int64 {
+	return must(c.Int64Slice())
+}
+
 func (c caster) Uint() (uint, error) {
-	return ToUnsigned[uint](c.v)
+	return casterValue(c, toUnsigned[uint], nil)
+}
+
+func (c caster) UintWith(opts ...Option) (uint, error) {
+	return casterValue(c, toUnsigned[uint], opts)
 }
 
 func (c caster) UintSafe(f uint) uint {
-	if v, err := ToUnsigned[uint](c.v); err == nil {
+	if v, err := casterValue(c, toUnsigned[uint], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint() uint {
+	return must(c.Uint())
+}
+
 func (c caster) UintSlice() ([]uint, error) {
-	return ToUnsignedSlice[uint](c.v)
+	return casterSlice(c, toUnsignedSlice[uint], nil)
+}
+
+func (c caster) UintSliceWith(opts ...Option) ([]uint, error) {
+	return casterSlice(c, toUnsignedSlice[uint], opts)
 }
 
 func (c caster) UintSliceSafe(f []uint) []uint {
-	if v, err := ToUnsignedSlice[uint](c.v); err == nil {
+	if v, err := casterSlice(c, toUnsignedSlice[uint], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUintSlice() []uint {
+	return must(c.UintSlice())
+}
+
 func (c caster) Uint8() (uint8, error) {
-	return ToUnsigned[uint8](c.v)
+	return casterValue(c, toUnsigned[uint8], nil)
+}
+
+func (c caster) Uint8With(opts ...Option) (uint8, error) {
+	return casterValue(c, toUnsigned[uint8], opts)
 }
 
 func (c caster) Uint8Safe(f uint8) uint8 {
-	if v, err := ToUnsigned[uint8](c.v); err == nil {
+	if v, err := casterValue(c, toUnsigned[uint8], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint8() uint8 {
+	return must(c.Uint8())
+}
+
 func (c caster) Uint8Slice() ([]uint8, error) {
-	return ToUnsignedSlice[uint8](c.v)
+	return casterSlice(c, toUnsignedSlice[uint8], nil)
+}
+
+func (c caster) Uint8SliceWith(opts ...Option) ([]uint8, error) {
+	return casterSlice(c, toUnsignedSlice[uint8], opts)
 }
 
 func (c caster) Uint8SliceSafe(f []uint8) []uint8 {
-	if v, err := ToUnsignedSlice[uint8](c.v); err == nil {
+	if v, err := casterSlice(c, toUnsignedSlice[uint8], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint8Slice() []uint8 {
+	return must(c.Uint8Slice())
+}
+
 func (c caster) Uint16() (uint16, error) {
-	return ToUnsigned[uint16](c.v)
+	return casterValue(c, toUnsigned[uint16], nil)
+}
+
+func (c caster) Uint16With(opts ...Option) (uint16, error) {
+	return casterValue(c, toUnsigned[uint16], opts)
 }
 
 func (c caster) Uint16Safe(f uint16) uint16 {
-	if v, err := ToUnsigned[uint16](c.v); err == nil {
+	if v, err := casterValue(c, toUnsigned[uint16], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint16() uint16 {
+	return must(c.Uint16())
+}
+
 func (c caster) Uint16Slice() ([]uint16, error) {
-	return ToUnsignedSlice[uint16](c.v)
+	return casterSlice(c, toUnsignedSlice[uint16], nil)
+}
+
+func (c caster) Uint16SliceWith(opts ...Option) ([]uint16, error) {
+	return casterSlice(c, toUnsignedSlice[uint16], opts)
 }
 
 func (c caster) Uint16SliceSafe(f []uint16) []uint16 {
-	if v, err := ToUnsignedSlice[uint16](c.v); err == nil {
+	if v, err := casterSlice(c, toUnsignedSlice[uint16], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint16Slice() []uint16 {
+	return must(c.Uint16Slice())
+}
+
 func (c caster) Uint32() (uint32, error) {
-	return ToUnsigned[uint32](c.v)
+	return casterValue(c, toUnsigned[uint32], nil)
+}
+
+func (c caster) Uint32With(opts ...Option) (uint32, error) {
+	return casterValue(c, toUnsigned[uint32], opts)
 }
 
 func (c caster) Uint32Safe(f uint32) uint32 {
-	if v, err := ToUnsigned[uint32](c.v); err == nil {
+	if v, err := casterValue(c, toUnsigned[uint32], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint32() uint32 {
+	return must(c.Uint32())
+}
+
 func (c caster) Uint32Slice() ([]uint32, error) {
-	return ToUnsignedSlice[uint32](c.v)
+	return casterSlice(c, toUnsignedSlice[uint32], nil)
+}
+
+func (c caster) Uint32SliceWith(opts ...Option) ([]uint32, error) {
+	return casterSlice(c, toUnsignedSlice[uint32], opts)
 }
 
 func (c caster) Uint32SliceSafe(f []uint32) []uint32 {
-	if v, err := ToUnsignedSlice[uint32](c.v); err == nil {
+	if v, err := casterSlice(c, toUnsignedSlice[uint32], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint32Slice() []uint32 {
+	return must(c.Uint32Slice())
+}
+
 func (c caster) Uint64() (uint64, error) {
-	return ToUnsigned[uint64](c.v)
+	return casterValue(c, toUnsigned[uint64], nil)
+}
+
+func (c caster) Uint64With(opts ...Option) (uint64, error) {
+	return casterValue(c, toUnsigned[uint64], opts)
 }
 
 func (c caster) Uint64Safe(f uint64) uint64 {
-	if v, err := ToUnsigned[uint64](c.v); err == nil {
+	if v, err := casterValue(c, toUnsigned[uint64], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint64() uint64 {
+	return must(c.Uint64())
+}
+
 func (c caster) Uint64Slice() ([]uint64, error) {
-	return ToUnsignedSlice[uint64](c.v)
+	return casterSlice(c, toUnsignedSlice[uint64], nil)
+}
+
+func (c caster) Uint64SliceWith(opts ...Option) ([]uint64, error) {
+	return casterSlice(c, toUnsignedSlice[uint64], opts)
 }
 
 func (c caster) Uint64SliceSafe(f []uint64) []uint64 {
-	if v, err := ToUnsignedSlice[uint64](c.v); err == nil {
+	if v, err := casterSlice(c, toUnsignedSlice[uint64], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustUint64Slice() []uint64 {
+	return must(c.Uint64Slice())
+}
+
 func (c caster) Float32() (float32, error) {
-	return ToFloat[float32](c.v)
+	return casterValue(c, toFloat[float32], nil)
+}
+
+func (c caster) Float32With(opts ...Option) (float32, error) {
+	return casterValue(c, toFloat[float32], opts)
 }
 
 func (c caster) Float32Safe(f float32) float32 {
-	if v, err := ToFloat[float32](c.v); err == nil {
+	if v, err := casterValue(c, toFloat[float32], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustFloat32() float32 {
+	return must(c.Float32())
+}
+
 func (c caster) Float32Slice() ([]float32, error) {
-	return ToFloatSlice[float32](c.v)
+	return casterSlice(c, toFloatSlice[float32], nil)
+}
+
+func (c caster) Float32SliceWith(opts ...Option) ([]float32, error) {
+	return casterSlice(c, toFloatSlice[float32], opts)
 }
 
 func (c caster) Float32SliceSafe(f []float32) []float32 {
-	if v, err := ToFloatSlice[float32](c.v); err == nil {
+	if v, err := casterSlice(c, toFloatSlice[float32], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustFloat32Slice() []float32 {
+	return must(c.Float32Slice())
+}
+
 func (c caster) Float64() (float64, error) {
-	return ToFloat[float64](c.v)
+	return casterValue(c, toFloat[float64], nil)
+}
+
+func (c caster) Float64With(opts ...Option) (float64, error) {
+	return casterValue(c, toFloat[float64], opts)
 }
 
 func (c caster) Float64Safe(f float64) float64 {
-	if v, err := ToFloat[float64](c.v); err == nil {
+	if v, err := casterValue(c, toFloat[float64], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustFloat64() float64 {
+	return must(c.Float64())
+}
+
 func (c caster) Float64Slice() ([]float64, error) {
-	return ToFloatSlice[float64](c.v)
+	return casterSlice(c, toFloatSlice[float64], nil)
+}
+
+func (c caster) Float64SliceWith(opts ...Option) ([]float64, error) {
+	return casterSlice(c, toFloatSlice[float64], opts)
 }
 
 func (c caster) Float64SliceSafe(f []float64) []float64 {
-	if v, err := ToFloatSlice[float64](c.v); err == nil {
+	if v, err := casterSlice(c, toFloatSlice[float64], nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustFloat64Slice() []float64 {
+	return must(c.Float64Slice())
+}
+
 func (c caster) String() (string, error) {
-	return ToString(c.v)
+	return casterValue(c, toString, nil)
+}
+
+func (c caster) StringWith(opts ...Option) (string, error) {
+	return casterValue(c, toString, opts)
 }
 func (c caster) StringSafe(f string) string {
-	if v, err := ToString(c.v); err == nil {
+	if v, err := casterValue(c, toString, nil); err == nil {
 		return v
 	}
 
 	return f
 }
 
+func (c caster) MustString() string {
+	return must(c.String())
+}
+
 func (c caster) StringSlice() ([]string, error) {
-	return ToStringSlice(c.v)
+	return casterSlice(c, toStringSlice, nil)
+}
+
+func (c caster) StringSliceWith(opts ...Option) ([]string, error) {
+	return casterSlice(c, toStringSlice, opts)
 }
 
 func (c caster) StringSliceSafe(f []string) []string {
-	if v, err := ToStringSlice(c.v); err == nil {
+	if v, err := casterSlice(c, toStringSlice, nil); err == nil {
 		return v
 	}
 
 	return f
 }
+
+func (c caster) MustStringSlice() []string {
+	return must(c.StringSlice())
+}
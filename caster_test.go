@@ -1,6 +1,8 @@
 package cast_test
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -122,3 +124,118 @@ func TestStringSliceSafe(t *testing.T) {
 	res := c.StringSliceSafe(fallback)
 	assert.Equal(t, fallback, res)
 }
+
+func TestMust(t *testing.T) {
+	c := cast.NewCaster("42")
+	assert.Equal(t, 42, c.MustInt())
+	assert.Equal(t, uint16(42), c.MustUint16())
+	assert.Equal(t, "42", c.MustString())
+	assert.Equal(t, []float64{42}, cast.NewCaster([]string{"42"}).MustFloat64Slice())
+
+	var castErr *cast.CastError
+	func() {
+		defer func() {
+			err, _ := recover().(error)
+			assert.ErrorAs(t, err, &castErr)
+		}()
+		cast.NewCaster("x").MustBool()
+	}()
+	assert.Equal(t, "bool", castErr.To)
+}
+
+func TestCachedCaster(t *testing.T) {
+	p := countingProvider{new(atomic.Int32)}
+	c := cast.NewCachedCaster(p)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, c.MustInt())
+			n, err := cast.As[int](c)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), p.calls.Load())
+
+	c = cast.NewCachedCaster([]string{"1", "x"})
+	_, err := c.IntSlice()
+	assert.True(t, cast.IsSyntaxError(err))
+	_, err = c.IntSlice()
+	assert.True(t, cast.IsSyntaxError(err))
+	assert.Equal(t, []int{0}, c.IntSliceSafe([]int{0}))
+
+	c = cast.NewCachedCaster([]string{"1", "2"})
+	ints := c.MustIntSlice()
+	ints[0] = 9
+	assert.Equal(t, []int{1, 2}, c.MustIntSlice())
+	assert.Equal(t, []string{"1", "2"}, c.MustStringSlice())
+	assert.Equal(t, "1", c.MustStringSlice()[0])
+
+	c = cast.NewCachedCaster("300", cast.WithClamp())
+	assert.Equal(t, int8(127), c.MustInt8())
+	assert.Equal(t, 300, c.MustInt())
+	assert.Equal(t, "300", c.MustString())
+}
+
+func BenchmarkCachedCaster(b *testing.B) {
+	casters := map[string]cast.Caster{
+		"plain":  cast.NewCaster("12345.5"),
+		"cached": cast.NewCachedCaster("12345.5"),
+	}
+
+	for name, c := range casters {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = c.Float64()
+			}
+		})
+	}
+}
+
+func TestCasterAsValue(t *testing.T) {
+	var (
+		_ cast.IntProvider         = cast.NewCaster(1)
+		_ cast.Float64Provider     = cast.NewCaster(1)
+		_ cast.StringSliceProvider = cast.NewCaster(1)
+		_ cast.SliceProvider       = cast.NewCaster(1)
+	)
+
+	n, err := cast.ToSigned[int](cast.NewCaster("42"))
+	assert.NoError(t, err)
+	assert.Equal(t, 42, n)
+
+	c := cast.NewCaster(nil, cast.WithNilPolicy(cast.NilAsZero))
+	n, err = cast.ToSigned[int](c)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	n, err = cast.As[int](c)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestCachedCasterReport(t *testing.T) {
+	report := &cast.SliceReport{}
+	c := cast.NewCachedCaster([]string{"1", "x"}, cast.WithInvalidPolicy(cast.InvalidSkip), cast.WithReport(report))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, []int{1}, c.IntSliceSafe(nil))
+		}()
+	}
+	wg.Wait()
+	assert.Empty(t, report.Invalid)
+
+	result, err := c.IntSliceWith(cast.WithReport(report))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, result)
+	assert.Equal(t, []int{1}, report.Invalid)
+}
@@ -0,0 +1,20 @@
+package cast
+
+// Signed is a constraint that permits any signed integer type, including named types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint that permits any unsigned integer type, including named types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Float is a constraint that permits any floating-point type, including named types.
+type Float interface {
+	~float32 | ~float64
+}
+
+type numeric interface {
+	Signed | Unsigned | Float | ~uintptr
+}
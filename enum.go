@@ -0,0 +1,343 @@
+package cast
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// EnumOption defines a function for configuring an enum registration.
+type EnumOption func(*enumSpec) error
+
+// EnumCaseInsensitive makes enum name lookups ignore letter case.
+func EnumCaseInsensitive() EnumOption {
+	return func(s *enumSpec) error {
+		s.insensitive = true
+		return nil
+	}
+}
+
+// EnumNumeric accepts the numeric representation of a registered value (e.g. 1 or "1").
+func EnumNumeric() EnumOption {
+	return func(s *enumSpec) error {
+		s.numeric = true
+		return nil
+	}
+}
+
+// EnumAliases registers additional names for enum values.
+// Aliases are accepted by ToEnum but never produced by ToString.
+func EnumAliases[T comparable](aliases map[string]T) EnumOption {
+	return func(s *enumSpec) error {
+		if s.typ != reflect.TypeFor[T]() {
+			return fmt.Errorf("enum %s: aliases of type %s: %w", s.typ, typeName[T](), ErrCast)
+		}
+		for name, value := range aliases {
+			s.aliases[name] = value
+		}
+		return nil
+	}
+}
+
+// EnumError represents a value that does not match any registered enum member.
+type EnumError struct {
+	Type    string
+	Value   interface{}
+	Allowed []string
+}
+
+func (e *EnumError) Error() string {
+	return fmt.Sprintf("value is not one of [%s]", strings.Join(e.Allowed, ", "))
+}
+
+// Unwrap returns ErrCast, so IsCastError reports true.
+func (e *EnumError) Unwrap() error {
+	return ErrCast
+}
+
+func newEnumError(typ string, value interface{}, spec *enumSpec, o *options) error {
+	e := &EnumError{Type: typ, Allowed: spec.allowed}
+	if !o.redact {
+		e.Value = value
+	}
+	return newCastError(typ, value, o, e)
+}
+
+type enumSpec struct {
+	typ         reflect.Type
+	names       map[string]interface{}
+	labels      map[interface{}]string
+	aliases     map[string]interface{}
+	allowed     []string
+	insensitive bool
+	numeric     bool
+}
+
+func (s *enumSpec) key(name string) string {
+	if s.insensitive {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+func (s *enumSpec) lookup(name string) (interface{}, bool) {
+	v, ok := s.names[s.key(name)]
+	return v, ok
+}
+
+var enums sync.Map // reflect.Type -> *enumSpec
+
+// RegisterEnum registers the names of the enum type T. Registered enums can be
+// parsed with ToEnum and ToEnumSlice and are rendered by their name in ToString.
+// Registering the same type again replaces the previous registration.
+func RegisterEnum[T comparable](names map[string]T, opts ...EnumOption) error {
+	spec := &enumSpec{
+		typ:     reflect.TypeFor[T](),
+		names:   make(map[string]interface{}, len(names)),
+		labels:  make(map[interface{}]string, len(names)),
+		aliases: make(map[string]interface{}),
+		allowed: make([]string, 0, len(names)),
+	}
+	title := spec.typ.String()
+
+	for _, opt := range opts {
+		if err := opt(spec); err != nil {
+			return err
+		}
+	}
+
+	for name, value := range names {
+		if prev, ok := spec.labels[value]; ok {
+			return fmt.Errorf("enum %s: names %q and %q share the same value", title, prev, name)
+		}
+		if _, ok := spec.names[spec.key(name)]; ok {
+			return fmt.Errorf("enum %s: duplicate name %q", title, name)
+		}
+		spec.labels[value] = name
+		spec.names[spec.key(name)] = value
+		spec.allowed = append(spec.allowed, name)
+	}
+
+	for name, value := range spec.aliases {
+		if _, ok := spec.labels[value]; !ok {
+			return fmt.Errorf("enum %s: alias %q refers to an unregistered value", title, name)
+		}
+		if _, ok := spec.names[spec.key(name)]; ok {
+			return fmt.Errorf("enum %s: duplicate name %q", title, name)
+		}
+		spec.names[spec.key(name)] = value
+	}
+
+	sort.Strings(spec.allowed)
+	enums.Store(spec.typ, spec)
+	return nil
+}
+
+// lookupEnum returns the registration of the given type, if any.
+func lookupEnum(t reflect.Type) (*enumSpec, bool) {
+	if s, ok := enums.Load(t); ok {
+		return s.(*enumSpec), true
+	}
+	return nil, false
+}
+
+// enumName returns the registered name of an enum value.
+func enumName(value interface{}) (string, bool) {
+	spec, ok := lookupEnum(reflect.TypeOf(value))
+	if !ok {
+		return "", false
+	}
+	name, ok := spec.labels[value]
+	return name, ok
+}
+
+// ToEnum converts an interface to the registered enum type T.
+// Names, aliases and (when enabled) numeric values are accepted.
+func ToEnum[T comparable](value interface{}, opts ...Option) (T, error) {
+	return toEnum[T](value, newOptions(opts))
+}
+
+func toEnum[T comparable](value interface{}, o *options) (T, error) {
+	v, err := convertEnum[T](value, o)
+	if err == nil && o.validators != nil {
+		err = o.validate(v, value, typeName[T]())
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+func convertEnum[T comparable](value interface{}, o *options) (T, error) {
+	title := typeName[T]()
+
+	var zero T
+	value, err := indirect(value, o)
+	if err != nil {
+		return zero, newCastError(title, value, o, err)
+	}
+
+	if value == nil {
+		return nilResult[T](o, title)
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[T](value, title, o); ok {
+		return v, err
+	}
+
+	spec, ok := lookupEnum(reflect.TypeFor[T]())
+	if !ok {
+		return zero, newCastError(title, value, o, fmt.Errorf("enum is not registered: %w", ErrUnsupported))
+	}
+
+	v, err := enumValue(spec, value, title, o)
+	if err != nil {
+		return zero, err
+	}
+	return v.(T), nil
+}
+
+// enumValue converts an interface to a registered value of the enum.
+func enumValue(spec *enumSpec, value interface{}, title string, o *options) (interface{}, error) {
+	// Handle values of the enum type
+	if reflect.TypeOf(value) == spec.typ {
+		if _, ok := spec.labels[value]; ok {
+			return value, nil
+		}
+		return nil, newEnumError(title, value, spec, o)
+	}
+
+	// Handle names and aliases
+	switch val := value.(type) {
+	case string:
+		if v, ok := spec.lookup(val); ok {
+			return v, nil
+		}
+	case StringProvider:
+		if s, err := val.String(); err == nil {
+			if v, ok := spec.lookup(s); ok {
+				return v, nil
+			}
+		}
+	case fmt.Stringer:
+		if v, ok := spec.lookup(val.String()); ok {
+			return v, nil
+		}
+	}
+
+	// Handle numeric values
+	if spec.numeric {
+		if v, ok := enumNumber(spec, value); ok {
+			return v, nil
+		}
+	}
+
+	return nil, newEnumError(title, value, spec, o)
+}
+
+// enumNumber converts a numeric value to a registered value of the enum type.
+// Values out of the range of the enum type and non-integral values of integer enums are rejected.
+func enumNumber(spec *enumSpec, value interface{}) (interface{}, bool) {
+	kind := spec.typ.Kind()
+	if kind != reflect.Float32 && kind != reflect.Float64 {
+		if f, err := toFloat[float64](value, defaultOptions); err == nil && f != math.Trunc(f) {
+			return nil, false
+		}
+	}
+
+	var (
+		n   interface{}
+		err error
+	)
+	switch kind {
+	case reflect.Int:
+		n, err = toSigned[int](value, defaultOptions)
+	case reflect.Int8:
+		n, err = toSigned[int8](value, defaultOptions)
+	case reflect.Int16:
+		n, err = toSigned[int16](value, defaultOptions)
+	case reflect.Int32:
+		n, err = toSigned[int32](value, defaultOptions)
+	case reflect.Int64:
+		n, err = toSigned[int64](value, defaultOptions)
+	case reflect.Uint:
+		n, err = toUnsigned[uint](value, defaultOptions)
+	case reflect.Uint8:
+		n, err = toUnsigned[uint8](value, defaultOptions)
+	case reflect.Uint16:
+		n, err = toUnsigned[uint16](value, defaultOptions)
+	case reflect.Uint32:
+		n, err = toUnsigned[uint32](value, defaultOptions)
+	case reflect.Uint64:
+		n, err = toUnsigned[uint64](value, defaultOptions)
+	case reflect.Uintptr:
+		var u uint64
+		if u, err = toUnsigned[uint64](value, defaultOptions); err == nil {
+			p, ok := inRange[uintptr](u)
+			if !ok {
+				return nil, false
+			}
+			n = p
+		}
+	case reflect.Float32:
+		n, err = toFloat[float32](value, defaultOptions)
+	case reflect.Float64:
+		n, err = toFloat[float64](value, defaultOptions)
+	default:
+		return nil, false
+	}
+	if err != nil {
+		return nil, false
+	}
+
+	v := reflect.ValueOf(n).Convert(spec.typ).Interface()
+	if _, ok := spec.labels[v]; ok {
+		return v, true
+	}
+	return nil, false
+}
+
+// ToEnumSlice converts an interface to a slice of the registered enum type T.
+func ToEnumSlice[T comparable](value interface{}, opts ...Option) ([]T, error) {
+	return toEnumSlice[T](value, newOptions(opts))
+}
+
+func toEnumSlice[T comparable](value interface{}, o *options) ([]T, error) {
+	o.resetReport()
+	title := typeName[[]T]()
+
+	value, err := indirect(value, o)
+	if err != nil {
+		return nil, newCastError(title, value, o, err)
+	}
+
+	if value == nil {
+		return nilResult[[]T](o, title)
+	}
+
+	// Handle validated conversions element by element
+	if o.validators != nil {
+		return validateSlice(nil, value, title, o, toEnum[T])
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[[]T](value, title, o); ok {
+		return v, err
+	}
+
+	// Handle slice providers
+	if s, ok, err := provideSlice(value); ok {
+		if err != nil {
+			return nil, newCastError(title, value, o, err)
+		}
+		value = s
+	}
+
+	// Handle slices or arrays of values
+	return convertSlice(nil, value, title, o, toEnum[T])
+}
@@ -0,0 +1,176 @@
+package cast_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uni-go/cast"
+)
+
+type status int
+
+const (
+	statusUnknown status = iota
+	statusActive
+	statusDisabled
+)
+
+type color string
+
+type priority int8
+
+func init() {
+	err := cast.RegisterEnum(map[string]status{
+		"unknown":  statusUnknown,
+		"active":   statusActive,
+		"disabled": statusDisabled,
+	},
+		cast.EnumCaseInsensitive(),
+		cast.EnumNumeric(),
+		cast.EnumAliases(map[string]status{"enabled": statusActive}),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	err = cast.RegisterEnum(map[string]color{"red": "r", "green": "g"})
+	if err != nil {
+		panic(err)
+	}
+
+	err = cast.RegisterEnum(map[string]priority{"high": 44}, cast.EnumNumeric())
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestRegisterEnum(t *testing.T) {
+	type level int
+
+	err := cast.RegisterEnum(map[string]level{"low": 1, "min": 1})
+	assert.Error(t, err)
+
+	err = cast.RegisterEnum(map[string]level{"low": 1}, cast.EnumAliases(map[string]level{"high": 2}))
+	assert.Error(t, err)
+
+	err = cast.RegisterEnum(map[string]level{"low": 1}, cast.EnumAliases(map[string]int{"high": 2}))
+	assert.Error(t, err)
+
+	err = cast.RegisterEnum(map[string]level{"Low": 1, "low": 2}, cast.EnumCaseInsensitive())
+	assert.Error(t, err)
+
+	err = cast.RegisterEnum(map[string]level{"low": 1, "high": 2})
+	assert.NoError(t, err)
+}
+
+func TestToEnum(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected status
+		err      bool
+	}{
+		{nil, 0, true},
+		{"active", statusActive, false},
+		{"DISABLED", statusDisabled, false},
+		{"Enabled", statusActive, false},
+		{statusDisabled, statusDisabled, false},
+		{1, statusActive, false},
+		{"2", statusDisabled, false},
+		{2.0, statusDisabled, false},
+		{status(9), 0, true},
+		{9, 0, true},
+		{"invalid", 0, true},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToEnum[status](test.input)
+		if test.err {
+			assert.Error(t, err)
+			assert.Equal(t, test.input != nil, cast.IsCastError(err))
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		}
+	}
+}
+
+func TestToEnumNumeric(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected priority
+		err      bool
+	}{
+		{44, 44, false},
+		{44.0, 44, false},
+		{"44", 44, false},
+		{uint64(44), 44, false},
+		{300, 0, true},
+		{44.7, 0, true},
+		{"44.7", 0, true},
+		{-212, 0, true},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToEnum[priority](test.input)
+		if test.err {
+			var enumErr *cast.EnumError
+			assert.True(t, errors.As(err, &enumErr), "%v", test.input)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		}
+	}
+}
+
+func TestToEnumError(t *testing.T) {
+	_, err := cast.ToEnum[color]("blue")
+
+	var enumErr *cast.EnumError
+	assert.True(t, errors.As(err, &enumErr))
+	assert.Equal(t, []string{"green", "red"}, enumErr.Allowed)
+	assert.Equal(t, "blue", enumErr.Value)
+
+	_, err = cast.ToEnum[color]("RED")
+	assert.Error(t, err)
+
+	_, err = cast.ToEnum[color](1)
+	assert.Error(t, err)
+
+	_, err = cast.ToEnum[struct{}]("any")
+	assert.True(t, cast.IsCastError(err))
+}
+
+func TestToEnumSlice(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected []status
+		err      bool
+	}{
+		{nil, nil, true},
+		{[]string{"active", "disabled"}, []status{statusActive, statusDisabled}, false},
+		{[]interface{}{"unknown", 1}, []status{statusUnknown, statusActive}, false},
+		{[]string{"active", "invalid"}, nil, true},
+		{"invalid", nil, true},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToEnumSlice[status](test.input)
+		if test.err {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		}
+	}
+}
+
+func TestToStringEnum(t *testing.T) {
+	result, err := cast.ToString(statusActive)
+	assert.NoError(t, err)
+	assert.Equal(t, "active", result)
+
+	slice, err := cast.ToStringSlice([]status{statusUnknown, statusDisabled})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"unknown", "disabled"}, slice)
+}
@@ -0,0 +1,187 @@
+package cast
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// Env returns a Caster for the value of the environment variable name.
+// The value of an unset variable is nil, so IsNil distinguishes it from an empty one.
+func Env(name string, opts ...Option) Caster {
+	if v, ok := os.LookupEnv(name); ok {
+		return NewCaster(v, opts...)
+	}
+	return NewCaster(nil, opts...)
+}
+
+// EnvOption defines a function for configuring BindEnv.
+type EnvOption func(*envSpec)
+
+type envSpec struct {
+	prefix string
+	sep    string
+	opts   []Option
+}
+
+// EnvPrefix prepends prefix to the variable names of all fields.
+func EnvPrefix(prefix string) EnvOption {
+	return func(s *envSpec) {
+		s.prefix = prefix
+	}
+}
+
+// EnvSeparator sets the separator of slice values. The default is ",".
+func EnvSeparator(sep string) EnvOption {
+	return func(s *envSpec) {
+		s.sep = sep
+	}
+}
+
+// EnvCastOptions sets the options used to convert the variables, e.g. validators.
+func EnvCastOptions(opts ...Option) EnvOption {
+	return func(s *envSpec) {
+		s.opts = append(s.opts, opts...)
+	}
+}
+
+// BindEnv fills the fields of the struct pointed to by v from environment variables.
+//
+// Fields are bound by the `env:"NAME"` tag, where `env:"NAME,required"` fails if the
+// variable is unset, and `env:"-"` skips the field. Unset variables keep the value of
+// the field, unless an `envDefault:"value"` tag is given. Slice values are split by
+// the separator, which can be overridden per field with an `envSeparator:";"` tag.
+// Struct fields without an env tag are bound recursively, with the names prefixed by
+// their `envPrefix:"PREFIX_"` tag.
+//
+// All fields are bound; the errors of failed fields are joined.
+func BindEnv(v interface{}, opts ...EnvOption) error {
+	spec := &envSpec{sep: ","}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(spec)
+		}
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return newUnsupportedError("struct pointer", v, defaultOptions)
+	}
+	return bindEnv(rv.Elem(), spec.prefix, spec, newOptions(spec.opts))
+}
+
+func bindEnv(rv reflect.Value, prefix string, spec *envSpec, o *options) error {
+	var errs []error
+	for i := 0; i < rv.NumField(); i++ {
+		field := rv.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, ok := field.Tag.Lookup("env")
+		if !ok {
+			if field.Type.Kind() == reflect.Struct {
+				errs = append(errs, bindEnv(rv.Field(i), prefix+field.Tag.Get("envPrefix"), spec, o))
+			}
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		if name == "-" {
+			continue
+		}
+		name = prefix + name
+
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			if flags == "required" {
+				errs = append(errs, fmt.Errorf("env %s: variable is required: %w", name, ErrNil))
+				continue
+			}
+			if value, ok = field.Tag.Lookup("envDefault"); !ok {
+				continue
+			}
+		}
+
+		sep := spec.sep
+		if s, ok := field.Tag.Lookup("envSeparator"); ok {
+			sep = s
+		}
+
+		res, err := toType(splitEnv(value, field.Type, sep), field.Type, o)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("env %s: %w", name, err))
+			continue
+		}
+		rv.Field(i).Set(reflect.ValueOf(res))
+	}
+	return errors.Join(errs...)
+}
+
+// splitEnv splits the value of a slice variable by sep, trimming spaces around elements.
+func splitEnv(value string, t reflect.Type, sep string) interface{} {
+	if t.Kind() != reflect.Slice {
+		return value
+	}
+
+	items := []string{}
+	if strings.TrimSpace(value) != "" {
+		for _, item := range strings.Split(value, sep) {
+			items = append(items, strings.TrimSpace(item))
+		}
+	}
+	return items
+}
+
+// toType converts an interface to the type t like To.
+func toType(value interface{}, t reflect.Type, o *options) (interface{}, error) {
+	title := t.String()
+
+	value, err := indirect(value, o)
+	if err != nil {
+		return nil, newCastError(title, value, o, err)
+	}
+
+	if value == nil {
+		if _, err := nilResult[interface{}](o, title); err != nil {
+			return nil, err
+		}
+		return reflect.Zero(t).Interface(), nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		v, err := toType(value, t.Elem(), o)
+		if err != nil {
+			return nil, err
+		}
+		ptr := reflect.New(t.Elem())
+		ptr.Elem().Set(reflect.ValueOf(v))
+		return ptr.Interface(), nil
+	case reflect.Slice:
+		items, err := toSlice(value, o)
+		if err != nil {
+			return nil, err
+		}
+		res := reflect.MakeSlice(t, 0, len(items))
+		for i, item := range items {
+			v, err := toType(item, t.Elem(), o)
+			if err != nil {
+				return nil, newElementError(title, value, i, err)
+			}
+			res = reflect.Append(res, reflect.ValueOf(v))
+		}
+		return res.Interface(), nil
+	}
+
+	if spec, ok := lookupEnum(t); ok {
+		v, err := enumValue(spec, value, title, o)
+		if err == nil {
+			err = o.validate(v, value, title)
+		}
+		return v, err
+	}
+	return toNamed(value, t, title, o)
+}
@@ -0,0 +1,96 @@
+package cast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uni-go/cast"
+)
+
+func TestEnv(t *testing.T) {
+	t.Setenv("CAST_TEST_PORT", "8080")
+	t.Setenv("CAST_TEST_EMPTY", "")
+
+	assert.Equal(t, 8080, cast.Env("CAST_TEST_PORT").IntSafe(80))
+	assert.Equal(t, 80, cast.Env("CAST_TEST_UNSET").IntSafe(80))
+
+	assert.True(t, cast.Env("CAST_TEST_UNSET").IsNil())
+	assert.False(t, cast.Env("CAST_TEST_EMPTY").IsNil())
+
+	_, err := cast.Env("CAST_TEST_UNSET").String()
+	assert.True(t, cast.IsNilError(err))
+
+	_, err = cast.Env("CAST_TEST_PORT").IntWith(cast.Max(1024))
+	assert.True(t, cast.IsValidationError(err))
+}
+
+type dbConfig struct {
+	Host string `env:"HOST" envDefault:"localhost"`
+	Port uint16 `env:"PORT" envDefault:"5432"`
+}
+
+type appConfig struct {
+	Name     string        `env:"NAME,required"`
+	Debug    bool          `env:"DEBUG"`
+	Timeout  time.Duration `env:"TIMEOUT" envDefault:"30s"`
+	Ratio    *float64      `env:"RATIO"`
+	Tags     []string      `env:"TAGS"`
+	Ports    []int         `env:"PORTS" envSeparator:";"`
+	Status   status        `env:"STATUS" envDefault:"active"`
+	Ignored  string        `env:"-"`
+	Kept     int           `env:"KEPT"`
+	DB       dbConfig      `envPrefix:"DB_"`
+	internal string
+}
+
+func TestBindEnv(t *testing.T) {
+	t.Setenv("APP_NAME", "api")
+	t.Setenv("APP_DEBUG", "1")
+	t.Setenv("APP_RATIO", "0.5")
+	t.Setenv("APP_TAGS", "a, b ,c")
+	t.Setenv("APP_PORTS", "80;443")
+	t.Setenv("APP_DB_HOST", "db")
+	t.Setenv("APP_IGNORED", "x")
+
+	cfg := appConfig{Kept: 7}
+	err := cast.BindEnv(&cfg, cast.EnvPrefix("APP_"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "api", cfg.Name)
+	assert.True(t, cfg.Debug)
+	assert.Equal(t, 30*time.Second, cfg.Timeout)
+	assert.Equal(t, 0.5, *cfg.Ratio)
+	assert.Equal(t, []string{"a", "b", "c"}, cfg.Tags)
+	assert.Equal(t, []int{80, 443}, cfg.Ports)
+	assert.Equal(t, statusActive, cfg.Status)
+	assert.Empty(t, cfg.Ignored)
+	assert.Equal(t, 7, cfg.Kept)
+	assert.Equal(t, dbConfig{Host: "db", Port: 5432}, cfg.DB)
+}
+
+func TestBindEnvErrors(t *testing.T) {
+	t.Setenv("DEBUG", "maybe")
+	t.Setenv("PORTS", "80;x")
+	t.Setenv("DB_PORT", "70000")
+
+	var cfg appConfig
+	err := cast.BindEnv(&cfg)
+	assert.True(t, cast.IsNilError(err))
+	assert.True(t, cast.IsSyntaxError(err))
+	assert.True(t, cast.IsOverflowError(err))
+	assert.ErrorContains(t, err, "env NAME: variable is required")
+	assert.ErrorContains(t, err, "env DEBUG:")
+	assert.ErrorContains(t, err, "env PORTS:")
+	assert.ErrorContains(t, err, "env DB_PORT:")
+
+	t.Setenv("NAME", "api")
+	t.Setenv("DEBUG", "true")
+	t.Setenv("PORTS", "80;8080")
+	t.Setenv("DB_PORT", "5432")
+	err = cast.BindEnv(&cfg, cast.EnvCastOptions(cast.Max(1024)))
+	assert.True(t, cast.IsValidationError(err))
+
+	assert.Error(t, cast.BindEnv(cfg))
+	assert.Error(t, cast.BindEnv(nil))
+}
@@ -3,37 +3,244 @@ package cast
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// sentinel is an error category that may belong to a broader parent category.
+type sentinel struct {
+	msg    string
+	parent error
+}
+
+func (e *sentinel) Error() string {
+	return e.msg
+}
+
+// Unwrap returns the parent category, if any.
+func (e *sentinel) Unwrap() error {
+	return e.parent
+}
+
+// Sentinel errors of the conversion categories. Conversion errors wrap one of them,
+// so they can be checked with errors.Is or the Is* helpers, and providers may wrap
+// them to return errors compatible with this package.
+var (
+	// ErrNil is returned when the value is nil.
+	ErrNil error = &sentinel{msg: "value is nil"}
+
+	// ErrCast is returned when the value cannot be converted to the target type.
+	ErrCast error = &sentinel{msg: "cannot convert value to the specified type"}
+
+	// ErrSyntax is returned when a string cannot be parsed as the target type. It is a kind of ErrCast.
+	ErrSyntax error = &sentinel{msg: "invalid syntax for the specified type", parent: ErrCast}
+
+	// ErrUnsupported is returned when the type of the value has no conversion to the target type.
+	// It is a kind of ErrCast.
+	ErrUnsupported error = &sentinel{msg: "unsupported type for the specified type", parent: ErrCast}
+
+	// ErrOverflow is returned when the value exceeds the range of the target type.
+	ErrOverflow error = &sentinel{msg: "value exceeds the allowable range"}
+
+	// ErrPrecision is returned when the value cannot be represented exactly by the target type.
+	ErrPrecision error = &sentinel{msg: "value loses precision"}
+
+	// ErrNaN is returned when the value is NaN or infinite and the target type cannot hold it.
+	ErrNaN error = &sentinel{msg: "value is NaN or infinite"}
+
+	// ErrValidation is returned when the converted value is rejected by a validator.
+	ErrValidation error = &sentinel{msg: "value is not valid"}
 )
 
 var (
-	errNil      = errors.New("value is nil")
-	errType     = errors.New("cannot convert value to the specified type")
-	errOverflow = errors.New("value exceeds the allowable range")
+	// ErrCycle is returned when a value contains a pointer cycle.
+	ErrCycle = errors.New("value contains a pointer cycle")
+
+	// ErrDepth is returned when a value exceeds the maximum dereference or nesting depth.
+	ErrDepth = errors.New("value exceeds the maximum depth")
 )
 
+// maxErrorValueLen is the maximum length of a value rendered in an error message.
+const maxErrorValueLen = 64
+
+// CastError describes a failed conversion.
+type CastError struct {
+	// From is the type of the source value, empty for nil values.
+	From string
+
+	// To is the target type.
+	To string
+
+	// Value is the offending value, nil for nil or redacted values.
+	// For slice elements the value is carried by the element error in Err.
+	Value interface{}
+
+	// Path is the index of the offending element of a slice conversion, e.g. "[3]",
+	// empty for the value itself. Elements are converted to scalars, so a slice element
+	// of a slice conversion fails with ErrUnsupported at its index.
+	Path string
+
+	// Err is the underlying cause.
+	Err error
+}
+
+func (e *CastError) Error() string {
+	var b strings.Builder
+	b.WriteString("cannot cast ")
+	if e.From == "" {
+		b.WriteString("<nil>")
+	} else {
+		b.WriteString(e.From)
+		if s := formatErrorValue(e.Value); s != "" {
+			b.WriteByte(' ')
+			b.WriteString(s)
+		}
+	}
+	b.WriteString(" to ")
+	b.WriteString(e.To)
+	if e.Path != "" {
+		b.WriteString(" at ")
+		b.WriteString(e.Path)
+	}
+	if e.Err != nil {
+		b.WriteString(": ")
+		b.WriteString(e.Err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the underlying cause.
+func (e *CastError) Unwrap() error {
+	return e.Err
+}
+
+// formatErrorValue renders a value for an error message, truncating long values.
+// Containers are rendered by their length and other structs and pointers are omitted,
+// unless they implement fmt.Stringer or error, as their elements may be large or cyclic.
+func formatErrorValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = strconv.Quote(v)
+	case fmt.Stringer, error:
+		s = fmt.Sprintf("%v", value)
+	default:
+		switch rv := reflect.ValueOf(value); rv.Kind() {
+		case reflect.Map, reflect.Slice, reflect.Array:
+			return "(len " + strconv.Itoa(rv.Len()) + ")"
+		case reflect.Struct, reflect.Pointer, reflect.Interface:
+			return ""
+		}
+		s = fmt.Sprintf("%v", value)
+	}
+
+	if r := []rune(s); len(r) > maxErrorValueLen {
+		s = string(r[:maxErrorValueLen]) + "..."
+	}
+	return s
+}
+
+func newCastError(typ string, value interface{}, o *options, err error) error {
+	e := &CastError{To: typ, Err: err}
+	if value != nil {
+		e.From = reflect.TypeOf(value).String()
+		if !o.redact {
+			e.Value = value
+		}
+	}
+	return e
+}
+
+// newElementError wraps the error of the element at index i of a slice conversion.
+func newElementError(typ string, value interface{}, i int, err error) error {
+	path := "[" + strconv.Itoa(i) + "]"
+	return &CastError{From: reflect.TypeOf(value).String(), To: typ, Path: path, Err: err}
+}
+
 func newNilError(typ string) error {
-	return fmt.Errorf("%s: %w", typ, errNil)
+	return &CastError{To: typ, Err: ErrNil}
+}
+
+func newSyntaxError(typ string, value interface{}, o *options) error {
+	return newCastError(typ, value, o, ErrSyntax)
+}
+
+func newUnsupportedError(typ string, value interface{}, o *options) error {
+	return newCastError(typ, value, o, ErrUnsupported)
+}
+
+func newPrecisionError(typ string, value interface{}, o *options) error {
+	return newCastError(typ, value, o, ErrPrecision)
 }
 
-func newTypeError(typ string) error {
-	return fmt.Errorf("%s: %w", typ, errType)
+func newNaNError(typ string, value interface{}, o *options) error {
+	return newCastError(typ, value, o, ErrNaN)
 }
 
-func newOverflowError(typ string) error {
-	return fmt.Errorf("%s: %w", typ, errOverflow)
+func newOverflowError(typ string, value interface{}, o *options) error {
+	return newCastError(typ, value, o, ErrOverflow)
+}
+
+// CastErrors returns the cast errors contained in err, including all errors
+// joined by collect mode (see WithCollectErrors).
+func CastErrors(err error) []*CastError {
+	var res []*CastError
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			res = append(res, CastErrors(e)...)
+		}
+		return res
+	}
+
+	var castErr *CastError
+	if errors.As(err, &castErr) {
+		res = append(res, castErr)
+	}
+	return res
 }
 
 // IsNilError returns true if the error is not nil and represents a nil value error.
 func IsNilError(err error) bool {
-	return errors.Is(err, errNil)
+	return errors.Is(err, ErrNil)
 }
 
-// IsCastError returns true if the error is not nil and represents a type casting error.
+// IsCastError returns true if the error is not nil and represents a type casting error,
+// including syntax and unsupported type errors.
 func IsCastError(err error) bool {
-	return errors.Is(err, errType)
+	return errors.Is(err, ErrCast)
+}
+
+// IsSyntaxError returns true if the error is not nil and represents an unparseable string.
+func IsSyntaxError(err error) bool {
+	return errors.Is(err, ErrSyntax)
+}
+
+// IsUnsupportedError returns true if the error is not nil and represents an unsupported type error.
+func IsUnsupportedError(err error) bool {
+	return errors.Is(err, ErrUnsupported)
 }
 
 // IsOverflowError returns true if the error is not nil and represents a value overflow error.
 func IsOverflowError(err error) bool {
-	return errors.Is(err, errOverflow)
+	return errors.Is(err, ErrOverflow)
+}
+
+// IsPrecisionError returns true if the error is not nil and represents a precision loss error.
+func IsPrecisionError(err error) bool {
+	return errors.Is(err, ErrPrecision)
+}
+
+// IsNaNError returns true if the error is not nil and represents a NaN or infinity error.
+func IsNaNError(err error) bool {
+	return errors.Is(err, ErrNaN)
+}
+
+// IsValidationError returns true if the error is not nil and represents a value rejected by a validator.
+func IsValidationError(err error) bool {
+	return errors.Is(err, ErrValidation)
 }
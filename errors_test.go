@@ -0,0 +1,175 @@
+package cast_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uni-go/cast"
+)
+
+func TestCastError(t *testing.T) {
+	_, err := cast.ToSigned[int8]("300")
+
+	var castErr *cast.CastError
+	assert.True(t, errors.As(err, &castErr))
+	assert.Equal(t, "string", castErr.From)
+	assert.Equal(t, "int8", castErr.To)
+	assert.Equal(t, "300", castErr.Value)
+	assert.True(t, cast.IsOverflowError(err))
+	assert.Equal(t, `cannot cast string "300" to int8: value exceeds the allowable range`, err.Error())
+
+	_, err = cast.ToBool(struct{}{})
+	assert.True(t, errors.As(err, &castErr))
+	assert.True(t, cast.IsCastError(err))
+	assert.Equal(t, "struct {}", castErr.From)
+
+	_, err = cast.ToString(nil)
+	assert.True(t, errors.As(err, &castErr))
+	assert.True(t, cast.IsNilError(err))
+	assert.Equal(t, "cannot cast <nil> to string: value is nil", err.Error())
+
+	_, err = cast.ToEnum[color]("blue")
+	assert.True(t, errors.As(err, &castErr))
+	assert.Equal(t, `cannot cast string "blue" to cast_test.color: value is not one of [green, red]`, err.Error())
+}
+
+func TestCastErrorRedacted(t *testing.T) {
+	_, err := cast.ToSigned[int]("secret", cast.WithRedactedValues())
+
+	var castErr *cast.CastError
+	assert.True(t, errors.As(err, &castErr))
+	assert.Nil(t, castErr.Value)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.True(t, cast.IsCastError(err))
+}
+
+func TestCastErrorProvider(t *testing.T) {
+	cause := errors.New("provider failed")
+	p := failingProvider{cause}
+
+	conversions := map[string]func() error{
+		"int":      func() error { _, err := cast.ToSigned[int](p); return err },
+		"bool":     func() error { _, err := cast.ToBool(p); return err },
+		"string":   func() error { _, err := cast.ToString(p); return err },
+		"[]bool":   func() error { _, err := cast.ToBoolSlice(p); return err },
+		"[]string": func() error { _, err := cast.ToStringSlice(p); return err },
+	}
+
+	for name, conv := range conversions {
+		err := conv()
+
+		var castErr *cast.CastError
+		assert.True(t, errors.As(err, &castErr), name)
+		assert.Equal(t, name, castErr.To)
+		assert.ErrorIs(t, err, cause)
+	}
+}
+
+type failingProvider struct {
+	err error
+}
+
+func (p failingProvider) Int() (int, error) {
+	return 0, p.err
+}
+
+func (p failingProvider) Bool() (bool, error) {
+	return false, p.err
+}
+
+func (p failingProvider) BoolSlice() ([]bool, error) {
+	return nil, p.err
+}
+
+func (p failingProvider) String() (string, error) {
+	return "", p.err
+}
+
+func (p failingProvider) StringSlice() ([]string, error) {
+	return nil, p.err
+}
+
+func TestCastErrorElement(t *testing.T) {
+	_, err := cast.ToSignedSlice[int8]([]interface{}{1, "2", "300"})
+
+	var castErr *cast.CastError
+	assert.True(t, errors.As(err, &castErr))
+	assert.Equal(t, "[2]", castErr.Path)
+	assert.Equal(t, "[]int8", castErr.To)
+	assert.Equal(t, "[]interface {}", castErr.From)
+	assert.True(t, cast.IsOverflowError(err))
+	assert.Equal(t, `cannot cast []interface {} to []int8 at [2]: cannot cast string "300" to int8: value exceeds the allowable range`, err.Error())
+
+	var elemErr *cast.CastError
+	assert.True(t, errors.As(castErr.Err, &elemErr))
+	assert.Equal(t, "300", elemErr.Value)
+	assert.Equal(t, "", elemErr.Path)
+
+	_, err = cast.ToStringSlice([]interface{}{"a", nil})
+	assert.True(t, errors.As(err, &castErr))
+	assert.Equal(t, "[1]", castErr.Path)
+	assert.True(t, cast.IsNilError(err))
+
+	_, err = cast.ToBoolSlice([]string{"true", "x"})
+	assert.True(t, errors.As(err, &castErr))
+	assert.Equal(t, "[1]", castErr.Path)
+
+	_, err = cast.ToSignedSlice[int]([][]interface{}{{1}, {2, "x"}})
+	assert.True(t, errors.As(err, &castErr))
+	assert.Equal(t, "[0]", castErr.Path)
+	assert.True(t, cast.IsUnsupportedError(err))
+	assert.True(t, cast.IsCastError(err))
+}
+
+func TestErrorCategories(t *testing.T) {
+	_, err := cast.ToSigned[int]("abc")
+	assert.True(t, cast.IsSyntaxError(err))
+	assert.True(t, cast.IsCastError(err))
+	assert.ErrorIs(t, err, cast.ErrSyntax)
+
+	_, err = cast.ToBool("maybe")
+	assert.True(t, cast.IsSyntaxError(err))
+
+	_, err = cast.ToFloat[float64](struct{}{})
+	assert.True(t, cast.IsUnsupportedError(err))
+	assert.True(t, cast.IsCastError(err))
+	assert.False(t, cast.IsSyntaxError(err))
+
+	_, err = cast.ToString([]int{1})
+	assert.True(t, cast.IsUnsupportedError(err))
+
+	_, err = cast.ToSignedSlice[int]("abc")
+	assert.True(t, cast.IsUnsupportedError(err))
+
+	_, err = cast.ToEnum[struct{}]("abc")
+	assert.True(t, cast.IsUnsupportedError(err))
+
+	_, err = cast.ToSigned[int](failingProvider{fmt.Errorf("bad input: %w", cast.ErrSyntax)})
+	assert.True(t, cast.IsSyntaxError(err))
+
+	assert.True(t, cast.IsPrecisionError(fmt.Errorf("wrapped: %w", cast.ErrPrecision)))
+	assert.True(t, cast.IsNaNError(fmt.Errorf("wrapped: %w", cast.ErrNaN)))
+	assert.False(t, cast.IsCastError(cast.ErrOverflow))
+}
+
+func TestCastErrorFormat(t *testing.T) {
+	s := []interface{}{nil}
+	s[0] = s
+
+	_, err := cast.ToString(s)
+	assert.Equal(t, "cannot cast []interface {} (len 1) to string: unsupported type for the specified type", err.Error())
+
+	m := map[string]interface{}{}
+	m["a"] = m
+	_, err = cast.ToSigned[int](m)
+	assert.Equal(t, "cannot cast map[string]interface {} (len 1) to int: unsupported type for the specified type", err.Error())
+
+	_, err = cast.ToSigned[int](struct{ v interface{} }{m})
+	assert.Equal(t, "cannot cast struct { v interface {} } to int: unsupported type for the specified type", err.Error())
+
+	_, err = cast.ToSignedSlice[int](time.Second)
+	assert.Equal(t, "cannot cast time.Duration 1s to []int: unsupported type for the specified type", err.Error())
+}
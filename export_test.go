@@ -0,0 +1,8 @@
+package cast
+
+// WithCheck adds a validator calling check, for counting validations in tests.
+func WithCheck(check func(v interface{}) error) Option {
+	return addValidator(func(v interface{}, _ *options) error {
+		return check(v)
+	})
+}
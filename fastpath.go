@@ -0,0 +1,120 @@
+package cast
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// elements holds the conversions of a target type from the element types of
+// common slices. They are used by fastSlice to convert these slices without reflection.
+type elements[T any] struct {
+	signed func(int64, any, *options) (T, error)
+	float  func(float64, any, *options) (T, error)
+	parse  func(string, any, *options) (T, error)
+	any    func(any, *options) (T, error)
+}
+
+func signedElements[T Signed]() elements[T] {
+	return elements[T]{signedToInteger[T], floatToInteger[T], parseInteger[T], toSigned[T]}
+}
+
+func unsignedElements[T Unsigned]() elements[T] {
+	return elements[T]{signedToInteger[T], floatToInteger[T], parseInteger[T], toUnsigned[T]}
+}
+
+func floatElements[T Float]() elements[T] {
+	return elements[T]{signedToFloat[T], floatToFloat[T], parseFloat[T], toFloat[T]}
+}
+
+var stringElements = elements[string]{
+	signed: func(n int64, _ any, _ *options) (string, error) {
+		return strconv.FormatInt(n, 10), nil
+	},
+	float: func(f float64, _ any, _ *options) (string, error) {
+		return strconv.FormatFloat(f, 'f', -1, 64), nil
+	},
+	parse: func(s string, _ any, _ *options) (string, error) {
+		return s, nil
+	},
+	any: toString,
+}
+
+var boolElements = elements[bool]{
+	signed: func(n int64, _ any, _ *options) (bool, error) {
+		return n != 0, nil
+	},
+	float: func(f float64, _ any, _ *options) (bool, error) {
+		return f != 0, nil
+	},
+	parse: func(s string, value any, o *options) (bool, error) {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return false, newSyntaxError("bool", value, o)
+		}
+		return v, nil
+	},
+	any: toBool,
+}
+
+// fastSlice converts []int, []int64, []float64, []string, []json.Number and []any
+// without reflection and appends the elements to dst. It reports false if the value
+// is not one of these slices, the conversion needs a report, or any element fails;
+// the caller then falls back to convertSlice, which applies the invalid policy and
+// builds the element errors. The depth limit applies like in convertSlice.
+// Elements are converted without boxing, so conversions get a nil value for errors.
+func fastSlice[T any](dst []T, value any, o *options, e elements[T]) ([]T, bool) {
+	if o.report != nil {
+		return dst, false
+	}
+
+	// Elements are one level deeper, like in convertSlice; the elements of
+	// typed slices are not nested further, so only []any needs the options.
+	if o.depth+1 >= o.limit() {
+		return dst, false
+	}
+
+	switch src := value.(type) {
+	case []int:
+		return convertEach(dst, src, func(x int) (T, error) { return e.signed(int64(x), nil, o) })
+	case []int64:
+		return convertEach(dst, src, func(x int64) (T, error) { return e.signed(x, nil, o) })
+	case []float64:
+		return convertEach(dst, src, func(x float64) (T, error) { return e.float(x, nil, o) })
+	case []string:
+		return convertEach(dst, src, func(x string) (T, error) { return e.parse(x, nil, o) })
+	case []json.Number:
+		return convertEach(dst, src, func(x json.Number) (T, error) { return e.parse(string(x), nil, o) })
+	case []any:
+		nested, err := o.enter()
+		if err != nil {
+			return dst, false
+		}
+		res := grow(dst, len(src))
+		for _, item := range src {
+			if o.nilPolicy == NilSkip && isNil(item, nested) {
+				continue
+			}
+			v, err := e.any(item, nested)
+			if err != nil {
+				return dst, false
+			}
+			res = append(res, v)
+		}
+		return res, true
+	}
+	return dst, false
+}
+
+// convertEach converts each element of src using conv and appends it to dst,
+// stopping at the first error.
+func convertEach[S, T any](dst []T, src []S, conv func(S) (T, error)) ([]T, bool) {
+	res := grow(dst, len(src))
+	for _, x := range src {
+		v, err := conv(x)
+		if err != nil {
+			return dst, false
+		}
+		res = append(res, v)
+	}
+	return res, true
+}
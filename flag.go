@@ -0,0 +1,123 @@
+package cast
+
+import (
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Flag is a flag.Value that parses its values with the conversions of the package.
+//
+// Slice flags accept comma-separated values and can be repeated; the first value
+// replaces the default and the following values are appended. Bool flags can be
+// given without a value and accept yes/no and on/off besides the values of ToBool.
+type Flag[T any] struct {
+	p   *T
+	o   *options
+	set bool
+}
+
+// NewFlag returns a flag.Value storing its value in p, which is set to value.
+func NewFlag[T any](p *T, value T, opts ...Option) *Flag[T] {
+	*p = value
+	return &Flag[T]{p: p, o: newOptions(opts)}
+}
+
+// FlagVar defines a flag with the specified name, default value and usage string
+// in fs, or in flag.CommandLine if fs is nil. It returns the address of the value.
+func FlagVar[T any](fs *flag.FlagSet, name string, value T, usage string, opts ...Option) *T {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	p := new(T)
+	fs.Var(NewFlag(p, value, opts...), name, usage)
+	return p
+}
+
+// Set parses s and sets or, for slices, appends the value of the flag.
+func (f *Flag[T]) Set(s string) error {
+	t := reflect.TypeFor[T]()
+	res, err := toType(splitFlag(s, t), t, f.o)
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(res)
+	if t.Kind() == reflect.Slice && f.set {
+		v = reflect.AppendSlice(reflect.ValueOf(*f.p), v)
+	}
+	*f.p = v.Interface().(T)
+	f.set = true
+	return nil
+}
+
+// String returns the value of the flag, with slice elements separated by commas.
+// A zero Flag returns the zero value of T, so flag.PrintDefaults omits zero defaults.
+func (f *Flag[T]) String() string {
+	var value T
+	if f != nil && f.p != nil {
+		value = *f.p
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return formatFlag(value)
+	}
+
+	items := make([]string, v.Len())
+	for i := range items {
+		items[i] = formatFlag(v.Index(i).Interface())
+	}
+	return strings.Join(items, ",")
+}
+
+// Get returns the value of the flag, or the zero value of T for a zero Flag.
+// It implements flag.Getter.
+func (f *Flag[T]) Get() any {
+	var value T
+	if f != nil && f.p != nil {
+		value = *f.p
+	}
+	return value
+}
+
+// IsBoolFlag reports whether the flag can be given without a value.
+func (f *Flag[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
+
+// splitFlag splits the value of a slice flag by commas and maps the lenient
+// bool values of bool flags.
+func splitFlag(s string, t reflect.Type) interface{} {
+	v := splitEnv(s, t, ",")
+	items, ok := v.([]string)
+	if !ok {
+		return flagBool(s, t)
+	}
+	for i, item := range items {
+		items[i] = flagBool(item, t.Elem()).(string)
+	}
+	return items
+}
+
+// flagBool maps yes/no and on/off to true and false for bool types.
+func flagBool(s string, t reflect.Type) interface{} {
+	if t.Kind() != reflect.Bool {
+		return s
+	}
+	switch strings.ToLower(s) {
+	case "yes", "y", "on":
+		return "true"
+	case "no", "n", "off":
+		return "false"
+	}
+	return s
+}
+
+func formatFlag(v interface{}) string {
+	if s, err := toString(v, defaultOptions); err == nil {
+		return s
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,106 @@
+package cast_test
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uni-go/cast"
+)
+
+func TestFlagVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	port := cast.FlagVar[uint16](fs, "port", 8080, "port")
+	timeout := cast.FlagVar(fs, "timeout", 30*time.Second, "timeout")
+	verbose := cast.FlagVar(fs, "v", false, "verbose")
+	color := cast.FlagVar(fs, "color", true, "color")
+	tags := cast.FlagVar(fs, "tag", []string{"default"}, "tags")
+	ids := cast.FlagVar[[]int](fs, "id", nil, "ids")
+	st := cast.FlagVar(fs, "status", statusActive, "status")
+	ratio := cast.FlagVar(fs, "ratio", 0.5, "ratio")
+
+	err := fs.Parse([]string{
+		"-port", "9090", "-timeout", "1m30s", "-v", "-color=off",
+		"-tag", "a, b", "-tag", "c", "-id", "1,2", "-id=3", "-status", "disabled",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint16(9090), *port)
+	assert.Equal(t, 90*time.Second, *timeout)
+	assert.True(t, *verbose)
+	assert.False(t, *color)
+	assert.Equal(t, []string{"a", "b", "c"}, *tags)
+	assert.Equal(t, []int{1, 2, 3}, *ids)
+	assert.Equal(t, statusDisabled, *st)
+	assert.Equal(t, 0.5, *ratio)
+
+	assert.Equal(t, "1m30s", fs.Lookup("timeout").Value.String())
+	assert.Equal(t, "a,b,c", fs.Lookup("tag").Value.String())
+	assert.Equal(t, "disabled", fs.Lookup("status").Value.String())
+	assert.Equal(t, 90*time.Second, fs.Lookup("timeout").Value.(flag.Getter).Get())
+	assert.Equal(t, "default", fs.Lookup("tag").DefValue)
+}
+
+func TestFlagErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{"syntax", []string{"-port", "http"}},
+		{"overflow", []string{"-port", "70000"}},
+		{"element", []string{"-id", "1,x"}},
+		{"validation", []string{"-port", "80"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			cast.FlagVar[uint16](fs, "port", 8080, "port", cast.Min(1024))
+			cast.FlagVar[[]int](fs, "id", nil, "ids")
+
+			assert.Error(t, fs.Parse(tt.args))
+		})
+	}
+}
+
+func TestFlagDefaults(t *testing.T) {
+	var out strings.Builder
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&out)
+
+	cast.FlagVar(fs, "v", false, "verbose")
+	cast.FlagVar(fs, "n", 0, "count")
+	cast.FlagVar(fs, "name", "", "name")
+	cast.FlagVar[[]string](fs, "tag", nil, "tags")
+	cast.FlagVar(fs, "port", 8080, "port")
+	fs.PrintDefaults()
+
+	assert.NotContains(t, out.String(), "(default false)")
+	assert.NotContains(t, out.String(), "(default 0)")
+	assert.NotContains(t, out.String(), `(default "")`)
+	assert.Contains(t, out.String(), "(default 8080)")
+	assert.Equal(t, "", (&cast.Flag[[]string]{}).String())
+	assert.Equal(t, "false", (&cast.Flag[bool]{}).String())
+	assert.Equal(t, 0, (&cast.Flag[int]{}).Get())
+	assert.Nil(t, (&cast.Flag[[]string]{}).Get().([]string))
+}
+
+func TestNewFlag(t *testing.T) {
+	var ports []int
+	f := cast.NewFlag(&ports, []int{80})
+	assert.Equal(t, "80", f.String())
+
+	assert.NoError(t, f.Set("443"))
+	assert.NoError(t, f.Set("8443"))
+	assert.Equal(t, []int{443, 8443}, ports)
+
+	var debug bool
+	assert.True(t, cast.NewFlag(&debug, false).IsBoolFlag())
+	assert.False(t, f.IsBoolFlag())
+}
@@ -1,9 +1,7 @@
 package cast
 
 import (
-	"fmt"
 	"reflect"
-	"strconv"
 )
 
 // Float32Provider defines an interface for providing a float32 value with an error.
@@ -26,35 +24,57 @@ type Float64SliceProvider interface {
 	Float64Slice() ([]float64, error)
 }
 
-// ToFloat converts an interface to a float type (float32 or float64).
-func ToFloat[T float32 | float64](value interface{}) (T, error) {
-	value = indirect(value)
-	title := typeName[T]()
-	tError := newTypeError(title)
-	oError := newOverflowError(title)
+// ToFloat converts an interface to a float type (float32 or float64)
+// or a named type whose underlying type is one of them.
+func ToFloat[T Float](value interface{}, opts ...Option) (T, error) {
+	return toFloat[T](value, newOptions(opts))
+}
+
+func toFloat[T Float](value interface{}, o *options) (T, error) {
+	v, err := convertFloat[T](value, o)
+	if err == nil && o.validators != nil {
+		err = o.validate(v, value, typeName[T]())
+	}
+	if err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+func convertFloat[T Float](value interface{}, o *options) (T, error) {
+	value, err := indirect(value, o)
+	if err != nil {
+		return 0, newCastError(typeName[T](), value, o, err)
+	}
+
+	if value == nil {
+		return nilResult[T](o, typeName[T]())
+	}
 
-	switch value.(type) {
-	case nil:
-		return 0, newNilError(title)
+	// Handle generic providers
+	if v, ok, err := provide[T](value, typeName[T](), o); ok {
+		if err != nil {
+			return 0, err
+		}
+		return floatToFloat[T](float64(v), value, o)
 	}
 
 	// Handle provider interfaces
-	var zero T
-	switch any(zero).(type) {
-	case float32:
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Float32:
 		if val, ok := value.(Float32Provider); ok {
 			if v, e := val.Float32(); e != nil {
-				return 0, fmt.Errorf("%s: %w", title, e)
+				return 0, newCastError(typeName[T](), value, o, e)
 			} else {
-				return T(v), nil
+				return floatToFloat[T](float64(v), value, o)
 			}
 		}
-	case float64:
+	case reflect.Float64:
 		if val, ok := value.(Float64Provider); ok {
 			if v, e := val.Float64(); e != nil {
-				return 0, fmt.Errorf("%s: %w", title, e)
+				return 0, newCastError(typeName[T](), value, o, e)
 			} else {
-				return T(v), nil
+				return floatToFloat[T](v, value, o)
 			}
 		}
 	}
@@ -66,121 +86,203 @@ func ToFloat[T float32 | float64](value interface{}) (T, error) {
 			return 1, nil
 		}
 		return 0, nil
-	case int, int8, int16, int32, int64:
-		if v, ok := inRange[T](reflect.ValueOf(val).Int()); ok {
-			return v, nil
-		}
-		return 0, oError
-	case uint, uint8, uint16, uint32, uint64:
-		if v, ok := inRange[T](reflect.ValueOf(val).Uint()); ok {
-			return v, nil
-		}
-		return 0, oError
-	case float32, float64:
-		if v, ok := inRange[T](reflect.ValueOf(val).Float()); ok {
-			return v, nil
-		}
-		return 0, oError
+	case int:
+		return signedToFloat[T](int64(val), value, o)
+	case int8:
+		return signedToFloat[T](int64(val), value, o)
+	case int16:
+		return signedToFloat[T](int64(val), value, o)
+	case int32:
+		return signedToFloat[T](int64(val), value, o)
+	case int64:
+		return signedToFloat[T](val, value, o)
+	case uint:
+		return unsignedToFloat[T](uint64(val), value, o)
+	case uint8:
+		return unsignedToFloat[T](uint64(val), value, o)
+	case uint16:
+		return unsignedToFloat[T](uint64(val), value, o)
+	case uint32:
+		return unsignedToFloat[T](uint64(val), value, o)
+	case uint64:
+		return unsignedToFloat[T](val, value, o)
+	case float32:
+		return floatToFloat[T](float64(val), value, o)
+	case float64:
+		return floatToFloat[T](val, value, o)
 	case string:
-		if f, err := strconv.ParseFloat(val, 64); err == nil {
-			if v, ok := inRange[T](f); ok {
-				return v, nil
-			}
-			return 0, oError
-		}
+		return parseFloat[T](val, value, o)
+	}
 
-		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
-			if v, ok := inRange[T](i); ok {
-				return v, nil
-			}
-			return 0, oError
+	// Handle the providers of other numeric types
+	if n, ok, err := provideNumber(value); ok {
+		if err != nil {
+			return 0, newCastError(typeName[T](), value, o, err)
 		}
+		return numberToFloat[T](n, value, o)
+	}
 
-		return 0, tError
-	default:
-		if f, err := strconv.ParseFloat(fmt.Sprint(val), 64); err == nil {
-			if v, ok := inRange[T](f); ok {
-				return v, nil
-			}
-			return 0, oError
+	// Handle named types by their underlying kind
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return 1, nil
 		}
+		return 0, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return signedToFloat[T](v.Int(), value, o)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return unsignedToFloat[T](v.Uint(), value, o)
+	case reflect.Float32, reflect.Float64:
+		return floatToFloat[T](v.Float(), value, o)
+	case reflect.String:
+		return parseFloat[T](v.String(), value, o)
+	}
 
-		if i, err := strconv.ParseInt(fmt.Sprint(val), 10, 64); err == nil {
-			if v, ok := inRange[T](i); ok {
-				return v, nil
-			}
-			return 0, oError
+	// Handle other types by their string representation
+	if s, ok := textOf(value); ok {
+		if v, err := parseFloat[T](s, value, o); err == nil || !IsSyntaxError(err) {
+			return v, err
 		}
+	}
+	return 0, newUnsupportedError(typeName[T](), value, o)
+}
 
-		return 0, tError
+// ToFloatOr converts an interface like ToFloat, returning fallback on error.
+func ToFloatOr[T Float](value interface{}, fallback T, opts ...Option) T {
+	if v, err := ToFloat[T](value, opts...); err == nil {
+		return v
 	}
+	return fallback
+}
+
+// MustFloat converts an interface like ToFloat, panicking with the error on failure.
+func MustFloat[T Float](value interface{}, opts ...Option) T {
+	return must(ToFloat[T](value, opts...))
 }
 
-// ToFloatSlice converts an interface to a slice of float types (float32 or float64).
-func ToFloatSlice[T float32 | float64](value interface{}) ([]T, error) {
-	value = indirect(value)
-	title := "[]" + typeName[T]()
-	tError := newTypeError(title)
-	oError := newOverflowError(title)
+// ToFloatClamp converts an interface to a float type like ToFloat, but saturates out of range
+// values to the minimum or maximum of T. It reports whether the value was clamped.
+func ToFloatClamp[T Float](value interface{}, opts ...Option) (T, bool, error) {
+	return clampConvert(value, newOptions(opts), toFloat[T])
+}
 
-	switch v := value.(type) {
-	case nil:
-		return nil, newNilError(title)
-	case []T:
+// ToFloatSlice converts an interface to a slice of float types (float32 or float64)
+// or of a named type whose underlying type is one of them.
+// A []T value is returned as is and shares its backing array; use WithCopy to get a copy.
+// With WithRejectNonFinite the elements of a []T value are checked, and a copy is returned.
+func ToFloatSlice[T Float](value interface{}, opts ...Option) ([]T, error) {
+	return toFloatSlice[T](value, newOptions(opts))
+}
+
+func toFloatSlice[T Float](value interface{}, o *options) ([]T, error) {
+	o.resetReport()
+	if v, ok := value.([]T); ok && v != nil && !o.copy && o.validators == nil && !o.rejectNonFinite {
 		return v, nil
 	}
+	return appendFloat[T](nil, value, o)
+}
+
+// ToFloatSliceOr converts an interface like ToFloatSlice, returning fallback on error.
+func ToFloatSliceOr[T Float](value interface{}, fallback []T, opts ...Option) []T {
+	if v, err := ToFloatSlice[T](value, opts...); err == nil {
+		return v
+	}
+	return fallback
+}
+
+// MustFloatSlice converts an interface like ToFloatSlice, panicking with the error on failure.
+func MustFloatSlice[T Float](value interface{}, opts ...Option) []T {
+	return must(ToFloatSlice[T](value, opts...))
+}
+
+// AppendFloat converts an interface like ToFloatSlice and appends the elements to dst.
+// On failure dst is returned unchanged.
+func AppendFloat[T Float](dst []T, value interface{}, opts ...Option) ([]T, error) {
+	return appendFloat(dst, value, newOptions(opts))
+}
+
+func appendFloat[T Float](dst []T, value interface{}, o *options) ([]T, error) {
+	o.resetReport()
+	title := typeName[[]T]()
+
+	value, err := indirect(value, o)
+	if err != nil {
+		return dst, newCastError(title, value, o, err)
+	}
+
+	if value == nil {
+		_, err := nilResult[[]T](o, title)
+		return dst, err
+	}
+
+	// Handle validated conversions element by element
+	if o.validators != nil {
+		return validateSlice(dst, value, title, o, toFloat[T])
+	}
+
+	// Slices of T and provided slices are converted element by element below
+	// if non-finite values are rejected
+	if v, ok := value.([]T); ok && !o.rejectNonFinite {
+		return append(grow(dst, len(v)), v...), nil
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[[]T](value, title, o); ok {
+		if err != nil {
+			return dst, err
+		}
+		if !o.rejectNonFinite {
+			return append(grow(dst, len(v)), v...), nil
+		}
+		value = v
+	}
 
 	// Handle provider interfaces
-	var zero T
-	switch any(zero).(type) {
-	case float32:
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Float32:
 		if val, ok := value.(Float32SliceProvider); ok {
 			if v, e := val.Float32Slice(); e != nil {
-				return nil, fmt.Errorf("%s: %w", title, e)
+				return dst, newCastError(title, value, o, e)
+			} else if o.rejectNonFinite {
+				value = v
 			} else {
-				result := make([]T, len(v))
-				for i, item := range v {
-					result[i] = T(item)
+				result := grow(dst, len(v))
+				for _, item := range v {
+					result = append(result, T(item))
 				}
 				return result, nil
 			}
 		}
-	case float64:
+	case reflect.Float64:
 		if val, ok := value.(Float64SliceProvider); ok {
 			if v, e := val.Float64Slice(); e != nil {
-				return nil, fmt.Errorf("%s: %w", title, e)
+				return dst, newCastError(title, value, o, e)
+			} else if o.rejectNonFinite {
+				value = v
 			} else {
-				result := make([]T, len(v))
-				for i, item := range v {
-					result[i] = T(item)
+				result := grow(dst, len(v))
+				for _, item := range v {
+					result = append(result, T(item))
 				}
 				return result, nil
 			}
 		}
 	}
 
-	// Handle slices and arrays
-	switch reflect.TypeOf(value).Kind() {
-	case reflect.Slice, reflect.Array:
-		arr := reflect.ValueOf(value)
-		res := make([]T, 0, arr.Len())
-		for i := 0; i < arr.Len(); i++ {
-			f, err := ToFloat[T](arr.Index(i).Interface())
-			if err != nil {
-				if IsNilError(err) {
-					return nil, newNilError(title)
-				} else if IsCastError(err) {
-					return nil, tError
-				} else if IsOverflowError(err) {
-					return nil, oError
-				} else {
-					return nil, fmt.Errorf("%s: %w", title, err)
-				}
-			}
-			res = append(res, f)
+	// Handle the providers of other slices
+	if s, ok, err := provideSlice(value); ok {
+		if err != nil {
+			return dst, newCastError(title, value, o, err)
 		}
+		value = s
+	}
+
+	// Handle common slices without reflection
+	if res, ok := fastSlice(dst, value, o, floatElements[T]()); ok {
 		return res, nil
 	}
 
-	return nil, newTypeError(title)
+	// Handle slices and arrays
+	return convertSlice(dst, value, title, o, toFloat[T])
 }
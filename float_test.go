@@ -118,3 +118,284 @@ func TestToFloat64Slice(t *testing.T) {
 		}
 	}
 }
+
+type ratio float32
+
+func TestToFloatNamed(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected ratio
+		err      bool
+	}{
+		{ratio(0.5), 0.5, false},
+		{level(-2), -2, false},
+		{port(7), 7, false},
+		{"0.25", 0.25, false},
+		{math.MaxFloat64, 0, true},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToFloat[ratio](test.input)
+		if test.err {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		}
+	}
+
+	result, err := cast.ToFloatSlice[ratio]([]port{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []ratio{1, 2}, result)
+}
+
+func TestToFloatNonFinite(t *testing.T) {
+	inputs := []interface{}{
+		math.NaN(), math.Inf(1), math.Inf(-1), "NaN", "-Inf", float32(math.Inf(1)),
+		float64Provider{math.NaN()}, float64Provider{math.Inf(1)},
+	}
+
+	for _, input := range inputs {
+		f64, err := cast.ToFloat[float64](input)
+		assert.NoError(t, err)
+		assert.True(t, math.IsNaN(f64) || math.IsInf(f64, 0))
+
+		f32, err := cast.ToFloat[float32](input)
+		assert.NoError(t, err)
+		assert.True(t, math.IsNaN(float64(f32)) || math.IsInf(float64(f32), 0))
+
+		_, err = cast.ToFloat[float64](input, cast.WithRejectNonFinite())
+		assert.True(t, cast.IsNaNError(err))
+
+		_, err = cast.ToFloat[float32](input, cast.WithRejectNonFinite())
+		assert.True(t, cast.IsNaNError(err))
+	}
+
+	_, err := cast.NewCaster("Inf", cast.WithRejectNonFinite()).Float64()
+	assert.True(t, cast.IsNaNError(err))
+
+	_, err = cast.ToFloatSlice[float64]([]string{"1", "NaN"}, cast.WithRejectNonFinite())
+	assert.True(t, cast.IsNaNError(err))
+
+	nan := cast.ProviderFunc[float64](func() (float64, error) { return math.NaN(), nil })
+	_, err = cast.ToFloat[float64](nan, cast.WithRejectNonFinite())
+	assert.True(t, cast.IsNaNError(err))
+
+	_, err = cast.To[float64](nan, cast.WithRejectNonFinite())
+	assert.True(t, cast.IsNaNError(err))
+}
+
+func TestToFloatProviderFallback(t *testing.T) {
+	result, err := cast.ToFloat[float32](int64Provider{-3})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(-3), result)
+
+	result, err = cast.ToFloat[float32](uint8Provider{7})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(7), result)
+
+	_, err = cast.ToFloat[float32](float64Provider{math.MaxFloat64})
+	assert.True(t, cast.IsOverflowError(err))
+
+	_, err = cast.ToFloat[float32](int64Provider{1<<24 + 1}, cast.WithPrecisionCheck())
+	assert.True(t, cast.IsPrecisionError(err))
+
+	_, err = cast.ToFloat[float32](float64Provider{0.1}, cast.WithPrecisionCheck())
+	assert.True(t, cast.IsPrecisionError(err))
+}
+
+func TestToFloatClamp(t *testing.T) {
+	result, clamped, err := cast.ToFloatClamp[float32](math.MaxFloat64)
+	assert.NoError(t, err)
+	assert.True(t, clamped)
+	assert.Equal(t, float32(math.MaxFloat32), result)
+
+	result, clamped, err = cast.ToFloatClamp[float32]("-1e300")
+	assert.NoError(t, err)
+	assert.True(t, clamped)
+	assert.Equal(t, float32(-math.MaxFloat32), result)
+
+	f64, clamped, err := cast.ToFloatClamp[float64]("1e400")
+	assert.NoError(t, err)
+	assert.True(t, clamped)
+	assert.Equal(t, math.MaxFloat64, f64)
+
+	f64, clamped, err = cast.ToFloatClamp[float64]("-1e400")
+	assert.NoError(t, err)
+	assert.True(t, clamped)
+	assert.Equal(t, -math.MaxFloat64, f64)
+
+	_, err = cast.ToFloat[float64]("1e400")
+	assert.True(t, cast.IsOverflowError(err))
+
+	result, clamped, err = cast.ToFloatClamp[float32](1.5)
+	assert.NoError(t, err)
+	assert.False(t, clamped)
+	assert.Equal(t, float32(1.5), result)
+}
+
+func TestToFloatPrecision(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		err   bool
+	}{
+		{0.5, false},
+		{0.1, true},
+		{"0.1", true},
+		{16777216, false},
+		{16777217, true},
+		{"16777217", true},
+		{uint64(1 << 40), false},
+		{uint64(1<<40 + 1), true},
+	}
+
+	for _, test := range tests {
+		_, err := cast.ToFloat[float32](test.input, cast.WithPrecisionCheck())
+		if test.err {
+			assert.True(t, cast.IsPrecisionError(err))
+		} else {
+			assert.NoError(t, err)
+		}
+
+		_, err = cast.ToFloat[float32](test.input)
+		assert.NoError(t, err)
+	}
+
+	_, err := cast.ToFloat[float64](int64(1<<53+1), cast.WithPrecisionCheck())
+	assert.True(t, cast.IsPrecisionError(err))
+
+	_, err = cast.ToFloat[float64](int64(math.MaxInt64), cast.WithPrecisionCheck())
+	assert.True(t, cast.IsPrecisionError(err))
+
+	_, err = cast.ToFloat[float64](uint64(math.MaxUint64), cast.WithPrecisionCheck())
+	assert.True(t, cast.IsPrecisionError(err))
+
+	f, err := cast.ToFloat[float64](int64(1<<53), cast.WithPrecisionCheck())
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1<<53), f)
+
+	for _, s := range []string{"18446744073709551615", "18446744073709551617", "100000000000000000000001"} {
+		_, err = cast.ToFloat[float64](s, cast.WithPrecisionCheck())
+		assert.True(t, cast.IsPrecisionError(err), s)
+	}
+
+	for _, s := range []string{"9223372036854775808", "+18446744073709551616", "100000000000000000000"} {
+		_, err = cast.ToFloat[float64](s, cast.WithPrecisionCheck())
+		assert.NoError(t, err, s)
+	}
+
+	_, err = cast.ToFloatSlice[float32]([]float64{0.5, 0.1}, cast.WithPrecisionCheck())
+	assert.True(t, cast.IsPrecisionError(err))
+
+	_, err = cast.NewCaster(0.1, cast.WithPrecisionCheck()).Float32()
+	assert.True(t, cast.IsPrecisionError(err))
+}
+
+func TestToFloatAllocs(t *testing.T) {
+	inputs := []interface{}{42, int8(-1), uint16(7), int64(1 << 30), 3.0, float32(2), "12345", "1.5", true, port(9)}
+
+	for _, input := range inputs {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = cast.ToFloat[float64](input)
+		})
+		assert.Zero(t, allocs, "%T %v", input, input)
+	}
+}
+
+func BenchmarkToFloat(b *testing.B) {
+	inputs := map[string]interface{}{
+		"int":     42,
+		"int64":   int64(1 << 30),
+		"float64": 3.0,
+		"string":  "12345",
+		"float":   "1.5",
+		"named":   port(9),
+	}
+
+	for name, input := range inputs {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = cast.ToFloat[float64](input)
+			}
+		})
+	}
+}
+
+func BenchmarkToFloatSlice(b *testing.B) {
+	ints := make([]int, 1000)
+	int16s := make([]int16, 1000)
+	floats := make([]float64, 1000)
+	uint16s := make([]uint16, 1000)
+	for i := range ints {
+		ints[i] = i
+		int16s[i] = int16(i)
+		floats[i] = float64(i) / 4
+		uint16s[i] = uint16(i)
+	}
+
+	inputs := []struct {
+		name  string
+		input interface{}
+	}{
+		{"int", ints},
+		{"float64", floats},
+		{"reflect/int16", int16s},
+		{"reflect/uint16", uint16s},
+	}
+
+	for _, input := range inputs {
+		b.Run(input.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = cast.ToFloatSlice[float32](input.input)
+			}
+		})
+	}
+}
+
+type float64SliceProvider []float64
+
+func (p float64SliceProvider) Float64Slice() ([]float64, error) { return p, nil }
+
+type float32SliceProvider []float32
+
+func (p float32SliceProvider) Float32Slice() ([]float32, error) { return p, nil }
+
+func TestToFloatSliceNonFinite(t *testing.T) {
+	nan := []float64{1, math.NaN()}
+	reject := cast.WithRejectNonFinite()
+
+	conversions := map[string]func() error{
+		"ToFloatSlice": func() error { _, err := cast.ToFloatSlice[float64](nan, reject); return err },
+		"WithCopy":     func() error { _, err := cast.ToFloatSlice[float64](nan, reject, cast.WithCopy()); return err },
+		"AppendFloat":  func() error { _, err := cast.AppendFloat[float64](nil, nan, reject); return err },
+		"Float64SliceProvider": func() error {
+			_, err := cast.ToFloatSlice[float64](float64SliceProvider(nan), reject)
+			return err
+		},
+		"Float32SliceProvider": func() error {
+			_, err := cast.ToFloatSlice[float32](float32SliceProvider{1, float32(math.Inf(1))}, reject)
+			return err
+		},
+		"Provider": func() error {
+			_, err := cast.ToFloatSlice[float64](cast.ProviderFunc[[]float64](func() ([]float64, error) { return nan, nil }), reject)
+			return err
+		},
+		"To":       func() error { _, err := cast.To[float64](math.NaN(), reject); return err },
+		"To slice": func() error { _, err := cast.To[[]float64](nan, reject); return err },
+		"Caster":   func() error { _, err := cast.NewCaster(nan, reject).Float64Slice(); return err },
+	}
+
+	for name, conv := range conversions {
+		assert.True(t, cast.IsNaNError(conv()), name)
+	}
+
+	result, err := cast.ToFloatSlice[float64](nan, reject, cast.WithInvalidPolicy(cast.InvalidSkip))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1}, result)
+
+	result, err = cast.ToFloatSlice[float64](float64SliceProvider(nan))
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+}
@@ -2,6 +2,7 @@ package cast
 
 import (
 	"reflect"
+	"slices"
 )
 
 // SliceProvider defines an interface for providing a slice of interface{} with an error.
@@ -10,13 +11,49 @@ type SliceProvider interface {
 }
 
 // ToSlice converts an interface to a slice of interface{}. Returns an error if the conversion is not possible.
-func ToSlice(value interface{}) ([]interface{}, error) {
-	value = indirect(value)
+// The result never shares the backing array of the value, except for the result of a
+// SliceProvider or Provider[[]interface{}], which is returned as is unless WithCopy is given.
+func ToSlice(value interface{}, opts ...Option) ([]interface{}, error) {
+	return toSlice(value, newOptions(opts))
+}
+
+func toSlice(value interface{}, o *options) ([]interface{}, error) {
+	o.resetReport()
+	value, err := indirect(value, o)
+	if err != nil {
+		return nil, newCastError("[]interface{}", value, o, err)
+	}
+
+	// Handle validated conversions element by element
+	if o.validators != nil {
+		return validateSlice(nil, value, "[]interface{}", o, validateElement)
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[[]interface{}](value, "[]interface{}", o); ok {
+		if err == nil && o.copy {
+			v = slices.Clone(v)
+		}
+		return v, err
+	}
+
 	switch v := value.(type) {
 	case nil:
-		return nil, newNilError("[]interface{}")
+		return nilResult[[]interface{}](o, "[]interface{}")
 	case SliceProvider:
-		return v.Slice()
+		res, err := v.Slice()
+		if err == nil && o.copy {
+			res = slices.Clone(res)
+		}
+		return res, err
+	}
+
+	// Handle typed slice providers
+	if s, ok, err := provideSlice(value); ok {
+		if err != nil {
+			return nil, newCastError("[]interface{}", value, o, err)
+		}
+		value = s
 	}
 
 	// Handle slices or arrays of values
@@ -25,10 +62,22 @@ func ToSlice(value interface{}) ([]interface{}, error) {
 		arr := reflect.ValueOf(value)
 		res := make([]interface{}, 0, arr.Len())
 		for i := 0; i < arr.Len(); i++ {
-			res = append(res, arr.Index(i).Interface())
+			item := arr.Index(i).Interface()
+			if o.nilPolicy == NilSkip && isNil(item, o) {
+				continue
+			}
+			res = append(res, item)
 		}
 		return res, nil
 	}
 
-	return nil, newTypeError("[]interface{}")
+	return nil, newUnsupportedError("[]interface{}", value, o)
+}
+
+// validateElement validates an element of a slice of interface{}, which is kept as is.
+func validateElement(value interface{}, o *options) (interface{}, error) {
+	if err := o.validate(value, value, "interface {}"); err != nil {
+		return nil, err
+	}
+	return value, nil
 }
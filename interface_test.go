@@ -1,6 +1,7 @@
 package cast_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,3 +32,58 @@ func TestToSlice(t *testing.T) {
 		}
 	}
 }
+
+type lazySlice struct {
+	items []interface{}
+	err   error
+}
+
+func (s lazySlice) Slice() ([]interface{}, error) { return s.items, s.err }
+
+type lazyStrings struct{ items []string }
+
+func (s lazyStrings) StringSlice() ([]string, error) { return s.items, nil }
+
+type lazyInts struct{ items []int64 }
+
+func (s lazyInts) Int64Slice() ([]int64, error) { return s.items, nil }
+
+func TestSliceProviderFallback(t *testing.T) {
+	lazy := lazySlice{items: []interface{}{1, "2", 3.0}}
+
+	ints, err := cast.ToSignedSlice[int32](lazy)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1, 2, 3}, ints)
+
+	uints, err := cast.ToUnsignedSlice[uint](lazy)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1, 2, 3}, uints)
+
+	floats, err := cast.ToFloatSlice[float64](lazy)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3}, floats)
+
+	strs, err := cast.ToStringSlice(lazy)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, strs)
+
+	bools, err := cast.ToBoolSlice(lazySlice{items: []interface{}{1, "false"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, bools)
+
+	ints, err = cast.ToSignedSlice[int32](lazyStrings{[]string{"4", "5"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{4, 5}, ints)
+
+	items, err := cast.ToSlice(lazyInts{[]int64{6}})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{int64(6)}, items)
+
+	_, err = cast.ToSignedSlice[int32](lazySlice{items: []interface{}{1, "x"}})
+	assert.True(t, cast.IsSyntaxError(err))
+
+	cause := errors.New("not decoded")
+	_, err = cast.ToSignedSlice[int32](lazySlice{err: cause})
+	assert.ErrorIs(t, err, cause)
+	assert.Len(t, cast.CastErrors(err), 1)
+}
@@ -0,0 +1,257 @@
+package cast
+
+// Option defines a function for configuring a conversion.
+type Option func(*options)
+
+// defaultMaxDepth is the default limit of pointer dereferences and nested conversions.
+const defaultMaxDepth = 32
+
+type options struct {
+	nilPolicy NilPolicy
+	maxDepth  int
+	depth     int
+	redact    bool
+	collect   bool
+	invalid   InvalidPolicy
+	fallback  interface{}
+	report    *SliceReport
+	copy      bool
+
+	validators []validator
+	notEmpty   bool
+
+	rejectNonFinite bool
+	clamp           bool
+	precision       bool
+}
+
+// defaultOptions are shared by all conversions without options and must not be modified.
+var defaultOptions = &options{}
+
+// newOptions applies the given options over the defaults.
+// Without options the shared defaults are returned, so the conversion does not allocate.
+func newOptions(opts []Option) *options {
+	if len(opts) == 0 {
+		return defaultOptions
+	}
+
+	o := &options{}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(o)
+		}
+	}
+	return o
+}
+
+// with applies the given options over o.
+// Without options o itself is returned.
+func (o *options) with(opts []Option) *options {
+	if len(opts) == 0 {
+		return o
+	}
+
+	merged := *o
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&merged)
+		}
+	}
+	return &merged
+}
+
+// limit returns the maximum depth of pointer dereferences and nested conversions.
+func (o *options) limit() int {
+	if o.maxDepth <= 0 {
+		return defaultMaxDepth
+	}
+	return o.maxDepth
+}
+
+// enter returns the options for a conversion nested one level deeper.
+func (o *options) enter() (*options, error) {
+	if o.depth+1 >= o.limit() {
+		return nil, ErrDepth
+	}
+	nested := *o
+	nested.depth++
+	return &nested, nil
+}
+
+// WithMaxDepth limits the number of pointer dereferences and nested conversions
+// applied to a value. Values exceeding the limit are reported with ErrDepth.
+// A limit less than or equal to zero restores the default of 32.
+func WithMaxDepth(n int) Option {
+	return func(o *options) {
+		o.maxDepth = n
+	}
+}
+
+// WithRedactedValues omits the offending value from errors, e.g. for sensitive input.
+func WithRedactedValues() Option {
+	return func(o *options) {
+		o.redact = true
+	}
+}
+
+// WithCollectErrors makes slice conversions convert every element instead of
+// stopping at the first failure. The partial result keeps failed elements as zero
+// values and is returned along with the joined errors of all failed elements.
+func WithCollectErrors() Option {
+	return func(o *options) {
+		o.collect = true
+	}
+}
+
+// WithCopy makes slice conversions always return a newly allocated slice.
+// Without it, a value that already has the target slice type (e.g. a []string
+// converted with ToStringSlice) is returned as is and shares its backing array.
+func WithCopy() Option {
+	return func(o *options) {
+		o.copy = true
+	}
+}
+
+// WithRejectNonFinite rejects NaN and infinite values for float targets with ErrNaN.
+// Integer targets always reject them.
+func WithRejectNonFinite() Option {
+	return func(o *options) {
+		o.rejectNonFinite = true
+	}
+}
+
+// WithClamp makes numeric conversions saturate out of range values to the minimum
+// or maximum of the target type instead of returning an overflow error.
+// NaN values are still rejected.
+func WithClamp() Option {
+	return func(o *options) {
+		o.clamp = true
+	}
+}
+
+// WithPrecisionCheck makes float conversions fail with ErrPrecision when the value
+// is not represented exactly by the target type, e.g. 0.1 or 16777217 as float32,
+// or integers above 2^53 as float64.
+func WithPrecisionCheck() Option {
+	return func(o *options) {
+		o.precision = true
+	}
+}
+
+// NilPolicy defines how nil values (including typed nils such as (*int)(nil),
+// nil maps and nil slices) are handled by conversions.
+type NilPolicy int
+
+const (
+	// NilAsError reports nil values with an error, see IsNilError. This is the default policy.
+	NilAsError NilPolicy = iota
+
+	// NilAsZero converts nil values to the zero value of the target type.
+	NilAsZero
+
+	// NilSkip drops nil elements from slice results.
+	// Nil values outside of slices are reported with an error.
+	NilSkip
+)
+
+// WithNilPolicy sets the policy applied to nil values.
+func WithNilPolicy(p NilPolicy) Option {
+	return func(o *options) {
+		o.nilPolicy = p
+	}
+}
+
+// nilResult resolves a nil value according to the nil policy.
+func nilResult[T any](o *options, title string) (T, error) {
+	var zero T
+	if o.nilPolicy == NilAsZero {
+		return zero, nil
+	}
+	return zero, newNilError(title)
+}
+
+// InvalidPolicy defines how slice conversions handle elements that cannot be converted.
+type InvalidPolicy int
+
+const (
+	// InvalidAsError fails the conversion for invalid elements. This is the default policy.
+	InvalidAsError InvalidPolicy = iota
+
+	// InvalidAsZero keeps invalid elements as zero values.
+	InvalidAsZero
+
+	// InvalidSkip drops invalid elements from the result.
+	InvalidSkip
+
+	// InvalidAsDefault replaces invalid elements with the default set by WithInvalidDefault.
+	InvalidAsDefault
+)
+
+// WithInvalidPolicy sets the policy applied to invalid slice elements.
+func WithInvalidPolicy(p InvalidPolicy) Option {
+	return func(o *options) {
+		o.invalid = p
+	}
+}
+
+// WithInvalidDefault replaces invalid slice elements with the given value,
+// converted to the element type.
+func WithInvalidDefault(v interface{}) Option {
+	return func(o *options) {
+		o.invalid = InvalidAsDefault
+		o.fallback = v
+	}
+}
+
+// SliceReport describes the elements of a slice conversion affected by a lenient policy or clamping.
+// A report is reset by every conversion it is passed to and must not be shared between
+// concurrent conversions.
+type SliceReport struct {
+	// Invalid contains the indices of the source elements that could not be converted.
+	Invalid []int
+
+	// Errors contains the errors of the invalid elements.
+	Errors []error
+
+	// Clamped contains the indices of the source elements saturated by WithClamp.
+	Clamped []int
+}
+
+// addInvalid records an invalid element.
+func (r *SliceReport) addInvalid(i int, err error) {
+	if r != nil {
+		r.Invalid = append(r.Invalid, i)
+		r.Errors = append(r.Errors, err)
+	}
+}
+
+// addClamped records a clamped element.
+func (r *SliceReport) addClamped(i int) {
+	if r != nil {
+		r.Clamped = append(r.Clamped, i)
+	}
+}
+
+// reset clears the report for a new conversion.
+func (r *SliceReport) reset() {
+	if r != nil {
+		r.Invalid = r.Invalid[:0]
+		r.Errors = r.Errors[:0]
+		r.Clamped = r.Clamped[:0]
+	}
+}
+
+// resetReport clears the report at the start of a top-level conversion.
+func (o *options) resetReport() {
+	if o.depth == 0 {
+		o.report.reset()
+	}
+}
+
+// WithReport fills the given report with the elements affected by the invalid policy
+// and by clamping.
+func WithReport(r *SliceReport) Option {
+	return func(o *options) {
+		o.report = r
+	}
+}
@@ -0,0 +1,299 @@
+package cast_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uni-go/cast"
+)
+
+func TestTypedNil(t *testing.T) {
+	var ptr *int
+	var ptrPtr **int = &ptr
+	var m map[string]int
+	var s []int
+
+	for _, input := range []interface{}{ptr, ptrPtr, m, s} {
+		_, err := cast.ToSigned[int](input)
+		assert.True(t, cast.IsNilError(err))
+
+		_, err = cast.ToBool(input)
+		assert.True(t, cast.IsNilError(err))
+
+		_, err = cast.ToString(input)
+		assert.True(t, cast.IsNilError(err))
+
+		_, err = cast.ToFloatSlice[float64](input)
+		assert.True(t, cast.IsNilError(err))
+
+		assert.True(t, cast.NewCaster(input).IsNil())
+	}
+
+	_, err := cast.ToSignedSlice[int]([]*int{ptr})
+	assert.True(t, cast.IsNilError(err))
+}
+
+func TestNilPolicy(t *testing.T) {
+	var ptr *int
+	one := 1
+
+	i, err := cast.ToSigned[int](ptr, cast.WithNilPolicy(cast.NilAsZero))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, i)
+
+	s, err := cast.ToString(nil, cast.WithNilPolicy(cast.NilAsZero))
+	assert.NoError(t, err)
+	assert.Equal(t, "", s)
+
+	ints, err := cast.ToSignedSlice[int]([]*int{&one, nil}, cast.WithNilPolicy(cast.NilAsZero))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 0}, ints)
+
+	ints, err = cast.ToSignedSlice[int]([]*int{&one, nil}, cast.WithNilPolicy(cast.NilSkip))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, ints)
+
+	strs, err := cast.ToStringSlice([]interface{}{"a", nil, ptr}, cast.WithNilPolicy(cast.NilSkip))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, strs)
+
+	items, err := cast.ToSlice([]interface{}{1, nil, ptr}, cast.WithNilPolicy(cast.NilSkip))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{1}, items)
+
+	_, err = cast.ToSigned[int](ptr, cast.WithNilPolicy(cast.NilSkip))
+	assert.True(t, cast.IsNilError(err))
+
+	c := cast.NewCaster(ptr, cast.WithNilPolicy(cast.NilAsZero))
+	assert.True(t, c.IsNil())
+	assert.Equal(t, 0, c.IntSafe(5))
+}
+
+type cyclic *cyclic
+
+func TestPointerCycle(t *testing.T) {
+	var p cyclic
+	p = &p
+
+	_, err := cast.ToSigned[int](p)
+	assert.ErrorIs(t, err, cast.ErrCycle)
+
+	_, err = cast.ToString(p)
+	assert.ErrorIs(t, err, cast.ErrCycle)
+
+	_, err = cast.ToSignedSlice[int]([]interface{}{1, p})
+	assert.ErrorIs(t, err, cast.ErrCycle)
+
+	var a, b interface{}
+	a = &b
+	b = &a
+	_, err = cast.ToBool(&a)
+	assert.ErrorIs(t, err, cast.ErrCycle)
+
+	c := cast.NewCaster(p)
+	assert.False(t, c.IsNil())
+	_, err = c.Int()
+	assert.ErrorIs(t, err, cast.ErrCycle)
+}
+
+func TestContainerCycle(t *testing.T) {
+	m := map[string]interface{}{}
+	m["a"] = m
+
+	s := []interface{}{nil}
+	s[0] = s
+
+	for _, input := range []interface{}{m, s} {
+		_, err := cast.ToSigned[int](input)
+		assert.True(t, cast.IsUnsupportedError(err))
+
+		_, err = cast.ToUnsigned[uint](input)
+		assert.True(t, cast.IsUnsupportedError(err))
+
+		_, err = cast.ToFloat[float64](input)
+		assert.True(t, cast.IsUnsupportedError(err))
+
+		_, err = cast.ToBool(input)
+		assert.True(t, cast.IsUnsupportedError(err))
+	}
+
+	_, err := cast.ToSignedSlice[int](s)
+	assert.True(t, cast.IsUnsupportedError(err))
+
+	_, err = cast.ToFloatSlice[float64](s)
+	assert.True(t, cast.IsUnsupportedError(err))
+}
+
+func TestMaxDepth(t *testing.T) {
+	v := 7
+	p1 := &v
+	p2 := &p1
+	p3 := &p2
+
+	i, err := cast.ToSigned[int](p3)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, i)
+
+	_, err = cast.ToSigned[int](p3, cast.WithMaxDepth(2))
+	assert.ErrorIs(t, err, cast.ErrDepth)
+
+	_, err = cast.ToSignedSlice[int]([]interface{}{p3}, cast.WithMaxDepth(3))
+	assert.ErrorIs(t, err, cast.ErrDepth)
+
+	_, err = cast.ToSignedSlice[int]([]interface{}{1}, cast.WithMaxDepth(1))
+	assert.ErrorIs(t, err, cast.ErrDepth)
+
+	for _, input := range []interface{}{[]string{"1"}, []int{1}, []float64{1}, []interface{}{"1"}, []uint8{1}} {
+		_, err = cast.ToSignedSlice[int64](input, cast.WithMaxDepth(1))
+		assert.ErrorIs(t, err, cast.ErrDepth, "%T", input)
+
+		_, err = cast.ToSignedSlice[int64](input, cast.WithMaxDepth(2))
+		assert.NoError(t, err, "%T", input)
+	}
+}
+
+func TestCollectErrors(t *testing.T) {
+	input := []interface{}{"1", "x", 3, "300", nil}
+
+	result, err := cast.ToSignedSlice[int8](input, cast.WithCollectErrors())
+	assert.Error(t, err)
+	assert.Equal(t, []int8{1, 0, 3, 0, 0}, result)
+
+	errs := cast.CastErrors(err)
+	assert.Len(t, errs, 3)
+	assert.Equal(t, "[1]", errs[0].Path)
+	assert.True(t, cast.IsCastError(errs[0]))
+	assert.Equal(t, "[3]", errs[1].Path)
+	assert.True(t, cast.IsOverflowError(errs[1]))
+	assert.Equal(t, "[4]", errs[2].Path)
+	assert.True(t, cast.IsNilError(errs[2]))
+
+	floats, err := cast.ToFloatSlice[float64]([]string{"1.5", "2.5"}, cast.WithCollectErrors())
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5, 2.5}, floats)
+
+	c := cast.NewCaster([]string{"true", "x", "false"}, cast.WithCollectErrors())
+	bools, err := c.BoolSlice()
+	assert.Equal(t, []bool{true, false, false}, bools)
+	assert.Len(t, cast.CastErrors(err), 1)
+}
+
+func TestInvalidPolicy(t *testing.T) {
+	input := []interface{}{"1.5", "garbage", 3, nil, "4"}
+	report := &cast.SliceReport{}
+
+	result, err := cast.ToFloatSlice[float64](input, cast.WithInvalidPolicy(cast.InvalidSkip), cast.WithReport(report))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5, 3, 4}, result)
+	assert.Equal(t, []int{1, 3}, report.Invalid)
+	assert.Len(t, report.Errors, 2)
+	assert.True(t, cast.IsCastError(report.Errors[0]))
+	assert.True(t, cast.IsNilError(report.Errors[1]))
+
+	result, err = cast.ToFloatSlice[float64](input, cast.WithInvalidPolicy(cast.InvalidAsZero), cast.WithReport(report))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5, 0, 3, 0, 4}, result)
+	assert.Equal(t, []int{1, 3}, report.Invalid)
+
+	result, err = cast.ToFloatSlice[float64](input, cast.WithInvalidDefault("-1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5, -1, 3, -1, 4}, result)
+
+	_, err = cast.ToFloatSlice[float64](input, cast.WithInvalidDefault("invalid"))
+	assert.True(t, cast.IsCastError(err))
+
+	c := cast.NewCaster([]string{"1", "x", "3"}, cast.WithInvalidPolicy(cast.InvalidSkip))
+	assert.Equal(t, []int{1, 3}, c.IntSliceSafe(nil))
+}
+
+func TestReportReset(t *testing.T) {
+	report := &cast.SliceReport{}
+	skip := cast.WithInvalidPolicy(cast.InvalidSkip)
+
+	conversions := map[string]func() error{
+		"passthrough": func() error { _, err := cast.ToSignedSlice[int]([]int{1, 2}, cast.WithReport(report)); return err },
+		"append":      func() error { _, err := cast.AppendString(nil, []string{"a"}, cast.WithReport(report)); return err },
+		"nil":         func() error { _, err := cast.ToFloatSlice[float64](nil, cast.WithReport(report)); return err },
+		"error":       func() error { _, err := cast.ToBoolSlice(1, cast.WithReport(report)); return err },
+		"provider": func() error {
+			_, err := cast.ToSignedSlice[int](cast.ProviderFunc[[]int](func() ([]int, error) { return nil, nil }), cast.WithReport(report))
+			return err
+		},
+		"To": func() error { _, err := cast.To[[]uint]([]uint{1}, cast.WithReport(report)); return err },
+		"enums": func() error {
+			_, err := cast.ToEnumSlice[status]([]string{"active"}, cast.WithReport(report))
+			return err
+		},
+	}
+
+	for name, conv := range conversions {
+		_, err := cast.ToSignedSlice[int]([]string{"1", "x"}, skip, cast.WithReport(report))
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1}, report.Invalid)
+
+		_ = conv()
+		assert.Empty(t, report.Invalid, name)
+		assert.Empty(t, report.Errors, name)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	ints := []int{1, 2}
+	result, err := cast.ToSignedSlice[int](ints)
+	assert.NoError(t, err)
+	assert.Same(t, &ints[0], &result[0])
+
+	result, err = cast.ToSignedSlice[int](ints, cast.WithCopy())
+	assert.NoError(t, err)
+	assert.Equal(t, ints, result)
+	result[0] = 9
+	assert.Equal(t, 1, ints[0])
+
+	strs := []string{"a"}
+	copied, err := cast.ToStringSlice(strs, cast.WithCopy())
+	assert.NoError(t, err)
+	copied[0] = "b"
+	assert.Equal(t, "a", strs[0])
+
+	bools := []bool{true}
+	flags, err := cast.ToBoolSlice(bools, cast.WithCopy())
+	assert.NoError(t, err)
+	flags[0] = false
+	assert.True(t, bools[0])
+
+	empty, err := cast.ToFloatSlice[float64]([]float64{}, cast.WithCopy())
+	assert.NoError(t, err)
+	assert.NotNil(t, empty)
+	assert.Empty(t, empty)
+
+	c := cast.NewCaster(ints, cast.WithCopy())
+	result, err = c.IntSlice()
+	assert.NoError(t, err)
+	result[1] = 9
+	assert.Equal(t, 2, ints[1])
+
+	result, err = cast.To[[]int](ints, cast.WithCopy())
+	assert.NoError(t, err)
+	result[0] = 9
+	assert.Equal(t, 1, ints[0])
+
+	result = cast.Must[[]int](ints, cast.WithCopy())
+	result[0] = 9
+	assert.Equal(t, 1, ints[0])
+
+	result, err = cast.As[[]int](cast.NewCaster(ints, cast.WithCopy()))
+	assert.NoError(t, err)
+	result[0] = 9
+	assert.Equal(t, 1, ints[0])
+
+	statuses := []status{statusActive}
+	named, err := cast.To[[]status](statuses, cast.WithCopy())
+	assert.NoError(t, err)
+	named[0] = statusDisabled
+	assert.Equal(t, statusActive, statuses[0])
+
+	provided, err := cast.To[[]int](cast.ProviderFunc[[]int](func() ([]int, error) { return ints, nil }), cast.WithCopy())
+	assert.NoError(t, err)
+	provided[0] = 9
+	assert.Equal(t, 1, ints[0])
+}
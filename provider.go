@@ -0,0 +1,305 @@
+package cast
+
+import (
+	"reflect"
+	"time"
+)
+
+// Provider defines an interface for providing a value of type T with an error.
+// It is checked by every conversion to T before any other conversion.
+type Provider[T any] interface {
+	CastTo() (T, error)
+}
+
+// ProviderFunc adapts a function to a Provider.
+type ProviderFunc[T any] func() (T, error)
+
+// CastTo calls f.
+func (f ProviderFunc[T]) CastTo() (T, error) {
+	return f()
+}
+
+// provide calls the Provider[T] implemented by value, if any.
+// It reports false if value does not implement Provider[T].
+func provide[T any](value interface{}, title string, o *options) (T, bool, error) {
+	var zero T
+	p, ok := value.(Provider[T])
+	if !ok {
+		return zero, false, nil
+	}
+	v, err := p.CastTo()
+	if err != nil {
+		return zero, true, newCastError(title, value, o, err)
+	}
+	return v, true, nil
+}
+
+// To converts an interface to T, which can be any type supported by the package:
+// bool, string, the numeric types, registered enums, named types based on them,
+// and slices of bool, string, interface{} and the numeric types.
+// Values implementing Provider[T] are converted by it and values of type T are returned as is;
+// slices are copied if WithCopy is given.
+func To[T any](value interface{}, opts ...Option) (T, error) {
+	return to[T](value, newOptions(opts))
+}
+
+func to[T any](value interface{}, o *options) (T, error) {
+	o.resetReport()
+	v, err := convertTo[T](value, o)
+	if err == nil && o.validators != nil {
+		switch reflect.TypeFor[T]().Kind() {
+		case reflect.Slice, reflect.Array:
+			// Slice conversions validate their elements
+		default:
+			err = o.validate(v, value, typeName[T]())
+		}
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v, nil
+}
+
+func convertTo[T any](value interface{}, o *options) (T, error) {
+	title := typeName[T]()
+
+	var zero T
+	value, err := indirect(value, o)
+	if err != nil {
+		return zero, newCastError(title, value, o, err)
+	}
+
+	if value == nil {
+		return nilResult[T](o, title)
+	}
+
+	if v, ok, err := provide[T](value, title, o); ok {
+		if err != nil {
+			return zero, err
+		}
+		return checkFloat(cloneSlice(v, o), value, title, o)
+	}
+
+	t := reflect.TypeFor[T]()
+	if spec, ok := lookupEnum(t); ok {
+		v, err := enumValue(spec, value, title, o)
+		if err != nil {
+			return zero, err
+		}
+		return v.(T), nil
+	}
+
+	if v, ok := value.(T); ok && (o.validators == nil || t.Kind() != reflect.Slice) {
+		return checkFloat(cloneSlice(v, o), value, title, o)
+	}
+
+	var res interface{}
+	switch any(zero).(type) {
+	case bool:
+		res, err = convertBool(value, o)
+	case string:
+		res, err = convertString(value, o)
+	case int:
+		res, err = convertSigned[int](value, o)
+	case int8:
+		res, err = convertSigned[int8](value, o)
+	case int16:
+		res, err = convertSigned[int16](value, o)
+	case int32:
+		res, err = convertSigned[int32](value, o)
+	case int64:
+		res, err = convertSigned[int64](value, o)
+	case uint:
+		res, err = convertUnsigned[uint](value, o)
+	case uint8:
+		res, err = convertUnsigned[uint8](value, o)
+	case uint16:
+		res, err = convertUnsigned[uint16](value, o)
+	case uint32:
+		res, err = convertUnsigned[uint32](value, o)
+	case uint64:
+		res, err = convertUnsigned[uint64](value, o)
+	case float32:
+		res, err = convertFloat[float32](value, o)
+	case float64:
+		res, err = convertFloat[float64](value, o)
+	case []bool:
+		res, err = toBoolSlice(value, o)
+	case []string:
+		res, err = toStringSlice(value, o)
+	case []interface{}:
+		res, err = toSlice(value, o)
+	case []int:
+		res, err = toSignedSlice[int](value, o)
+	case []int8:
+		res, err = toSignedSlice[int8](value, o)
+	case []int16:
+		res, err = toSignedSlice[int16](value, o)
+	case []int32:
+		res, err = toSignedSlice[int32](value, o)
+	case []int64:
+		res, err = toSignedSlice[int64](value, o)
+	case []uint:
+		res, err = toUnsignedSlice[uint](value, o)
+	case []uint8:
+		res, err = toUnsignedSlice[uint8](value, o)
+	case []uint16:
+		res, err = toUnsignedSlice[uint16](value, o)
+	case []uint32:
+		res, err = toUnsignedSlice[uint32](value, o)
+	case []uint64:
+		res, err = toUnsignedSlice[uint64](value, o)
+	case []float32:
+		res, err = toFloatSlice[float32](value, o)
+	case []float64:
+		res, err = toFloatSlice[float64](value, o)
+	default:
+		res, err = convertNamed(value, t, title, o)
+	}
+	if err != nil {
+		return zero, err
+	}
+	return res.(T), nil
+}
+
+// checkFloat applies the NaN and precision policies of float conversions to a value
+// of a float type, or to the elements of a float slice, that is returned as is.
+// Other values are returned unchanged.
+func checkFloat[T any](v T, value interface{}, title string, o *options) (T, error) {
+	var zero T
+	if !o.rejectNonFinite && !o.precision {
+		return v, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f, err := checkFloatValue(rv, value, o)
+		if err != nil {
+			return zero, err
+		}
+		return f.Interface().(T), nil
+	case reflect.Slice:
+		switch rv.Type().Elem().Kind() {
+		case reflect.Float32, reflect.Float64:
+			for i := 0; i < rv.Len(); i++ {
+				if _, err := checkFloatValue(rv.Index(i), rv.Index(i).Interface(), o); err != nil {
+					return zero, newElementError(title, value, i, err)
+				}
+			}
+		}
+	}
+	return v, nil
+}
+
+// checkFloatValue converts a float value to its own type with floatToFloat.
+func checkFloatValue(v reflect.Value, value interface{}, o *options) (reflect.Value, error) {
+	var (
+		f   interface{}
+		err error
+	)
+	if v.Kind() == reflect.Float32 {
+		f, err = floatToFloat[float32](v.Float(), value, o)
+	} else {
+		f, err = floatToFloat[float64](v.Float(), value, o)
+	}
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	return reflect.ValueOf(f).Convert(v.Type()), nil
+}
+
+// cloneSlice returns a copy of v if it is a slice and WithCopy is given.
+func cloneSlice[T any](v T, o *options) T {
+	if !o.copy {
+		return v
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice || rv.IsNil() {
+		return v
+	}
+	return reflect.AppendSlice(reflect.MakeSlice(rv.Type(), 0, rv.Len()), rv).Interface().(T)
+}
+
+// ToOr converts an interface like To, returning fallback on error.
+func ToOr[T any](value interface{}, fallback T, opts ...Option) T {
+	if v, err := To[T](value, opts...); err == nil {
+		return v
+	}
+	return fallback
+}
+
+// Must converts an interface like To, panicking with the error on failure.
+// It simplifies conversions that cannot fail or are checked at initialization.
+func Must[T any](value interface{}, opts ...Option) T {
+	return must(To[T](value, opts...))
+}
+
+// toNamed converts an interface to a named type by its underlying kind and validates the result.
+func toNamed(value interface{}, t reflect.Type, title string, o *options) (interface{}, error) {
+	v, err := convertNamed(value, t, title, o)
+	if err == nil && o.validators != nil {
+		err = o.validate(v, value, title)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// convertNamed converts an interface to a named type by its underlying kind.
+// Strings with units, such as "1m30s", are accepted for time.Duration.
+func convertNamed(value interface{}, t reflect.Type, title string, o *options) (interface{}, error) {
+	if t == reflect.TypeFor[time.Duration]() {
+		if s, ok := value.(string); ok {
+			if d, err := time.ParseDuration(s); err == nil {
+				return d, nil
+			}
+		}
+	}
+
+	var (
+		res interface{}
+		err error
+	)
+	switch t.Kind() {
+	case reflect.Bool:
+		res, err = convertBool(value, o)
+	case reflect.String:
+		res, err = convertString(value, o)
+	case reflect.Int:
+		res, err = convertSigned[int](value, o)
+	case reflect.Int8:
+		res, err = convertSigned[int8](value, o)
+	case reflect.Int16:
+		res, err = convertSigned[int16](value, o)
+	case reflect.Int32:
+		res, err = convertSigned[int32](value, o)
+	case reflect.Int64:
+		res, err = convertSigned[int64](value, o)
+	case reflect.Uint:
+		res, err = convertUnsigned[uint](value, o)
+	case reflect.Uint8:
+		res, err = convertUnsigned[uint8](value, o)
+	case reflect.Uint16:
+		res, err = convertUnsigned[uint16](value, o)
+	case reflect.Uint32:
+		res, err = convertUnsigned[uint32](value, o)
+	case reflect.Uint64:
+		res, err = convertUnsigned[uint64](value, o)
+	case reflect.Float32:
+		res, err = convertFloat[float32](value, o)
+	case reflect.Float64:
+		res, err = convertFloat[float64](value, o)
+	default:
+		return nil, newUnsupportedError(title, value, o)
+	}
+	if err != nil {
+		if e, ok := err.(*CastError); ok {
+			e.To = title
+		}
+		return nil, err
+	}
+	return reflect.ValueOf(res).Convert(t).Interface(), nil
+}
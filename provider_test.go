@@ -0,0 +1,106 @@
+package cast_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uni-go/cast"
+)
+
+type celsius struct{ degrees float64 }
+
+func (c celsius) CastTo() (float64, error) { return c.degrees, nil }
+
+func TestProvider(t *testing.T) {
+	f, err := cast.ToFloat[float64](celsius{21.5})
+	assert.NoError(t, err)
+	assert.Equal(t, 21.5, f)
+
+	n, err := cast.ToSigned[int](cast.ProviderFunc[int](func() (int, error) { return 7, nil }))
+	assert.NoError(t, err)
+	assert.Equal(t, 7, n)
+
+	s, err := cast.ToString(cast.ProviderFunc[string](func() (string, error) { return "x", nil }))
+	assert.NoError(t, err)
+	assert.Equal(t, "x", s)
+
+	b, err := cast.ToBool(cast.ProviderFunc[bool](func() (bool, error) { return true, nil }))
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	ints, err := cast.ToSignedSlice[int](cast.ProviderFunc[[]int](func() ([]int, error) { return []int{1, 2}, nil }))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, ints)
+
+	e, err := cast.ToEnum[status](cast.ProviderFunc[status](func() (status, error) { return statusActive, nil }))
+	assert.NoError(t, err)
+	assert.Equal(t, statusActive, e)
+
+	cause := errors.New("unavailable")
+	_, err = cast.ToSigned[int](cast.ProviderFunc[int](func() (int, error) { return 0, cause }))
+	assert.ErrorIs(t, err, cause)
+	assert.Len(t, cast.CastErrors(err), 1)
+}
+
+func TestTo(t *testing.T) {
+	n, err := cast.To[int16]("12")
+	assert.NoError(t, err)
+	assert.Equal(t, int16(12), n)
+
+	_, err = cast.To[int8](300)
+	assert.True(t, cast.IsOverflowError(err))
+
+	s, err := cast.To[string](1.5)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.5", s)
+
+	floats, err := cast.To[[]float32]([]string{"1", "2.5"})
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1, 2.5}, floats)
+
+	l, err := cast.To[level]("3")
+	assert.NoError(t, err)
+	assert.Equal(t, level(3), l)
+
+	_, err = cast.To[level](200)
+	assert.True(t, cast.IsOverflowError(err))
+	assert.Equal(t, "cast_test.level", cast.CastErrors(err)[0].To)
+
+	d, err := cast.To[time.Duration](int64(time.Second))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, d)
+
+	st, err := cast.To[status]("disabled")
+	assert.NoError(t, err)
+	assert.Equal(t, statusDisabled, st)
+
+	_, err = cast.To[status](status(9))
+	assert.Error(t, err)
+
+	m, err := cast.To[map[string]int](map[string]int{"a": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1}, m)
+
+	c, err := cast.To[celsius](cast.ProviderFunc[celsius](func() (celsius, error) { return celsius{1}, nil }))
+	assert.NoError(t, err)
+	assert.Equal(t, celsius{1}, c)
+
+	_, err = cast.To[celsius](1)
+	assert.True(t, cast.IsUnsupportedError(err))
+
+	_, err = cast.To[int](nil)
+	assert.True(t, cast.IsNilError(err))
+}
+
+func TestToOrMust(t *testing.T) {
+	assert.Equal(t, 8080, cast.ToOr("8080", 80))
+	assert.Equal(t, 80, cast.ToOr("http", 80))
+	assert.Equal(t, level(2), cast.ToOr[level](nil, 2))
+
+	assert.Equal(t, []string{"1"}, cast.Must[[]string]([]int{1}))
+	assert.PanicsWithError(t, `cannot cast string "x" to int: invalid syntax for the specified type`, func() {
+		cast.Must[int]("x")
+	})
+}
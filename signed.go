@@ -1,9 +1,7 @@
 package cast
 
 import (
-	"fmt"
 	"reflect"
-	"strconv"
 )
 
 // IntProvider defines an interface for providing a int value with an error.
@@ -56,57 +54,76 @@ type Int64SliceProvider interface {
 	Int64Slice() ([]int64, error)
 }
 
-// ToSigned converts an interface to a signed integer type (int, int8, int16, int32, int64).
-func ToSigned[T int | int8 | int16 | int32 | int64](value interface{}) (T, error) {
-	value = indirect(value)
-	title := typeName[T]()
-	tError := newTypeError(title)
-	oError := newOverflowError(title)
+// ToSigned converts an interface to a signed integer type (int, int8, int16, int32, int64)
+// or a named type whose underlying type is one of them.
+func ToSigned[T Signed](value interface{}, opts ...Option) (T, error) {
+	return toSigned[T](value, newOptions(opts))
+}
+
+func toSigned[T Signed](value interface{}, o *options) (T, error) {
+	v, err := convertSigned[T](value, o)
+	if err == nil && o.validators != nil {
+		err = o.validate(v, value, typeName[T]())
+	}
+	if err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+func convertSigned[T Signed](value interface{}, o *options) (T, error) {
+	value, err := indirect(value, o)
+	if err != nil {
+		return 0, newCastError(typeName[T](), value, o, err)
+	}
+
+	if value == nil {
+		return nilResult[T](o, typeName[T]())
+	}
 
-	switch value.(type) {
-	case nil:
-		return 0, newNilError(title)
+	// Handle generic providers
+	if v, ok, err := provide[T](value, typeName[T](), o); ok {
+		return v, err
 	}
 
 	// Handle provider interfaces
-	var zero T
-	switch any(zero).(type) {
-	case int:
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int:
 		if val, ok := value.(IntProvider); ok {
 			if v, e := val.Int(); e != nil {
-				return 0, fmt.Errorf("%s: %w", title, e)
+				return 0, newCastError(typeName[T](), value, o, e)
 			} else {
 				return T(v), nil
 			}
 		}
-	case int8:
+	case reflect.Int8:
 		if val, ok := value.(Int8Provider); ok {
 			if v, e := val.Int8(); e != nil {
-				return 0, fmt.Errorf("%s: %w", title, e)
+				return 0, newCastError(typeName[T](), value, o, e)
 			} else {
 				return T(v), nil
 			}
 		}
-	case int16:
+	case reflect.Int16:
 		if val, ok := value.(Int16Provider); ok {
 			if v, e := val.Int16(); e != nil {
-				return 0, fmt.Errorf("%s: %w", title, e)
+				return 0, newCastError(typeName[T](), value, o, e)
 			} else {
 				return T(v), nil
 			}
 		}
-	case int32:
+	case reflect.Int32:
 		if val, ok := value.(Int32Provider); ok {
 			if v, e := val.Int32(); e != nil {
-				return 0, fmt.Errorf("%s: %w", title, e)
+				return 0, newCastError(typeName[T](), value, o, e)
 			} else {
 				return T(v), nil
 			}
 		}
-	case int64:
+	case reflect.Int64:
 		if val, ok := value.(Int64Provider); ok {
 			if v, e := val.Int64(); e != nil {
-				return 0, fmt.Errorf("%s: %w", title, e)
+				return 0, newCastError(typeName[T](), value, o, e)
 			} else {
 				return T(v), nil
 			}
@@ -120,157 +137,229 @@ func ToSigned[T int | int8 | int16 | int32 | int64](value interface{}) (T, error
 			return 1, nil
 		}
 		return 0, nil
-	case int, int8, int16, int32, int64:
-		if v, ok := inRange[T](reflect.ValueOf(val).Int()); ok {
-			return v, nil
-		}
-		return 0, oError
-	case uint, uint8, uint16, uint32, uint64:
-		if v, ok := inRange[T](reflect.ValueOf(val).Uint()); ok {
-			return v, nil
-		}
-		return 0, oError
-	case float32, float64:
-		if v, ok := inRange[T](reflect.ValueOf(val).Float()); ok {
-			return v, nil
-		}
-		return 0, oError
+	case int:
+		return signedToInteger[T](int64(val), value, o)
+	case int8:
+		return signedToInteger[T](int64(val), value, o)
+	case int16:
+		return signedToInteger[T](int64(val), value, o)
+	case int32:
+		return signedToInteger[T](int64(val), value, o)
+	case int64:
+		return signedToInteger[T](val, value, o)
+	case uint:
+		return unsignedToInteger[T](uint64(val), value, o)
+	case uint8:
+		return unsignedToInteger[T](uint64(val), value, o)
+	case uint16:
+		return unsignedToInteger[T](uint64(val), value, o)
+	case uint32:
+		return unsignedToInteger[T](uint64(val), value, o)
+	case uint64:
+		return unsignedToInteger[T](val, value, o)
+	case float32:
+		return floatToInteger[T](float64(val), value, o)
+	case float64:
+		return floatToInteger[T](val, value, o)
 	case string:
-		if i, err := strconv.ParseInt(val, 10, 64); err == nil {
-			if v, ok := inRange[T](i); ok {
-				return v, nil
-			}
-			return 0, oError
-		}
+		return parseInteger[T](val, value, o)
+	}
 
-		if f, err := strconv.ParseFloat(val, 64); err == nil {
-			if v, ok := inRange[T](f); ok {
-				return v, nil
-			}
-			return 0, oError
+	// Handle the providers of other numeric types
+	if n, ok, err := provideNumber(value); ok {
+		if err != nil {
+			return 0, newCastError(typeName[T](), value, o, err)
 		}
+		return numberToInteger[T](n, value, o)
+	}
 
-		return 0, tError
-	default:
-		if i, err := strconv.ParseInt(fmt.Sprint(val), 10, 64); err == nil {
-			if v, ok := inRange[T](i); ok {
-				return v, nil
-			}
-			return 0, oError
+	// Handle named types by their underlying kind
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return 1, nil
 		}
+		return 0, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return signedToInteger[T](v.Int(), value, o)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return unsignedToInteger[T](v.Uint(), value, o)
+	case reflect.Float32, reflect.Float64:
+		return floatToInteger[T](v.Float(), value, o)
+	case reflect.String:
+		return parseInteger[T](v.String(), value, o)
+	}
 
-		if f, err := strconv.ParseFloat(fmt.Sprint(val), 64); err == nil {
-			if v, ok := inRange[T](f); ok {
-				return v, nil
-			}
-			return 0, oError
+	// Handle other types by their string representation
+	if s, ok := textOf(value); ok {
+		if v, err := parseInteger[T](s, value, o); err == nil || !IsSyntaxError(err) {
+			return v, err
 		}
+	}
+	return 0, newUnsupportedError(typeName[T](), value, o)
+}
 
-		return 0, tError
+// ToSignedOr converts an interface like ToSigned, returning fallback on error.
+func ToSignedOr[T Signed](value interface{}, fallback T, opts ...Option) T {
+	if v, err := ToSigned[T](value, opts...); err == nil {
+		return v
 	}
+	return fallback
+}
+
+// MustSigned converts an interface like ToSigned, panicking with the error on failure.
+func MustSigned[T Signed](value interface{}, opts ...Option) T {
+	return must(ToSigned[T](value, opts...))
 }
 
-// ToSignedSlice converts an interface to a slice of signed integers (int, int8, int16, int32, int64).
-func ToSignedSlice[T int | int8 | int16 | int32 | int64](value interface{}) ([]T, error) {
-	value = indirect(value)
-	title := "[]" + typeName[T]()
-	tError := newTypeError(title)
-	oError := newOverflowError(title)
-
-	switch v := value.(type) {
-	case nil:
-		return nil, newNilError(title)
-	case []T:
+// ToSignedClamp converts an interface to a signed integer type like ToSigned, but saturates out of range
+// values to the minimum or maximum of T. It reports whether the value was clamped.
+func ToSignedClamp[T Signed](value interface{}, opts ...Option) (T, bool, error) {
+	return clampConvert(value, newOptions(opts), toSigned[T])
+}
+
+// ToSignedSlice converts an interface to a slice of signed integers (int, int8, int16, int32, int64)
+// or of a named type whose underlying type is one of them.
+// A []T value is returned as is and shares its backing array; use WithCopy to get a copy.
+func ToSignedSlice[T Signed](value interface{}, opts ...Option) ([]T, error) {
+	return toSignedSlice[T](value, newOptions(opts))
+}
+
+func toSignedSlice[T Signed](value interface{}, o *options) ([]T, error) {
+	o.resetReport()
+	if v, ok := value.([]T); ok && v != nil && !o.copy && o.validators == nil {
 		return v, nil
 	}
+	return appendSigned[T](nil, value, o)
+}
+
+// ToSignedSliceOr converts an interface like ToSignedSlice, returning fallback on error.
+func ToSignedSliceOr[T Signed](value interface{}, fallback []T, opts ...Option) []T {
+	if v, err := ToSignedSlice[T](value, opts...); err == nil {
+		return v
+	}
+	return fallback
+}
+
+// MustSignedSlice converts an interface like ToSignedSlice, panicking with the error on failure.
+func MustSignedSlice[T Signed](value interface{}, opts ...Option) []T {
+	return must(ToSignedSlice[T](value, opts...))
+}
+
+// AppendSigned converts an interface like ToSignedSlice and appends the elements to dst.
+// On failure dst is returned unchanged.
+func AppendSigned[T Signed](dst []T, value interface{}, opts ...Option) ([]T, error) {
+	return appendSigned(dst, value, newOptions(opts))
+}
+
+func appendSigned[T Signed](dst []T, value interface{}, o *options) ([]T, error) {
+	o.resetReport()
+	title := typeName[[]T]()
+
+	value, err := indirect(value, o)
+	if err != nil {
+		return dst, newCastError(title, value, o, err)
+	}
+
+	if value == nil {
+		_, err := nilResult[[]T](o, title)
+		return dst, err
+	}
+
+	// Handle validated conversions element by element
+	if o.validators != nil {
+		return validateSlice(dst, value, title, o, toSigned[T])
+	}
+
+	if v, ok := value.([]T); ok {
+		return append(grow(dst, len(v)), v...), nil
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[[]T](value, title, o); ok {
+		if err != nil {
+			return dst, err
+		}
+		return append(grow(dst, len(v)), v...), nil
+	}
 
 	// Handle provider interfaces
-	var zero T
-	switch any(zero).(type) {
-	case int:
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Int:
 		if val, ok := value.(IntSliceProvider); ok {
 			if v, e := val.IntSlice(); e != nil {
-				return nil, fmt.Errorf("%s: %w", title, e)
+				return dst, newCastError(title, value, o, e)
 			} else {
-				result := make([]T, len(v))
-				for i, item := range v {
-					result[i] = T(item)
+				result := grow(dst, len(v))
+				for _, item := range v {
+					result = append(result, T(item))
 				}
 				return result, nil
 			}
 		}
-	case int8:
+	case reflect.Int8:
 		if val, ok := value.(Int8SliceProvider); ok {
 			if v, e := val.Int8Slice(); e != nil {
-				return nil, fmt.Errorf("%s: %w", title, e)
+				return dst, newCastError(title, value, o, e)
 			} else {
-				result := make([]T, len(v))
-				for i, item := range v {
-					result[i] = T(item)
+				result := grow(dst, len(v))
+				for _, item := range v {
+					result = append(result, T(item))
 				}
 				return result, nil
 			}
 		}
-	case int16:
+	case reflect.Int16:
 		if val, ok := value.(Int16SliceProvider); ok {
 			if v, e := val.Int16Slice(); e != nil {
-				return nil, fmt.Errorf("%s: %w", title, e)
+				return dst, newCastError(title, value, o, e)
 			} else {
-				result := make([]T, len(v))
-				for i, item := range v {
-					result[i] = T(item)
+				result := grow(dst, len(v))
+				for _, item := range v {
+					result = append(result, T(item))
 				}
 				return result, nil
 			}
 		}
-	case int32:
+	case reflect.Int32:
 		if val, ok := value.(Int32SliceProvider); ok {
 			if v, e := val.Int32Slice(); e != nil {
-				return nil, fmt.Errorf("%s: %w", title, e)
+				return dst, newCastError(title, value, o, e)
 			} else {
-				result := make([]T, len(v))
-				for i, item := range v {
-					result[i] = T(item)
+				result := grow(dst, len(v))
+				for _, item := range v {
+					result = append(result, T(item))
 				}
 				return result, nil
 			}
 		}
-	case int64:
+	case reflect.Int64:
 		if val, ok := value.(Int64SliceProvider); ok {
 			if v, e := val.Int64Slice(); e != nil {
-				return nil, fmt.Errorf("%s: %w", title, e)
+				return dst, newCastError(title, value, o, e)
 			} else {
-				result := make([]T, len(v))
-				for i, item := range v {
-					result[i] = T(item)
+				result := grow(dst, len(v))
+				for _, item := range v {
+					result = append(result, T(item))
 				}
 				return result, nil
 			}
 		}
 	}
 
-	// Handle slices and arrays
-	switch reflect.TypeOf(value).Kind() {
-	case reflect.Slice, reflect.Array:
-		arr := reflect.ValueOf(value)
-		res := make([]T, 0, arr.Len())
-		for i := 0; i < arr.Len(); i++ {
-			f, err := ToSigned[T](arr.Index(i).Interface())
-			if err != nil {
-				if IsNilError(err) {
-					return nil, newNilError(title)
-				} else if IsCastError(err) {
-					return nil, tError
-				} else if IsOverflowError(err) {
-					return nil, oError
-				} else {
-					return nil, fmt.Errorf("%s: %w", title, err)
-				}
-			}
-			res = append(res, f)
+	// Handle the providers of other slices
+	if s, ok, err := provideSlice(value); ok {
+		if err != nil {
+			return dst, newCastError(title, value, o, err)
 		}
+		value = s
+	}
+
+	// Handle common slices without reflection
+	if res, ok := fastSlice(dst, value, o, signedElements[T]()); ok {
 		return res, nil
 	}
 
-	return nil, newTypeError(title)
+	// Handle slices and arrays
+	return convertSlice(dst, value, title, o, toSigned[T])
 }
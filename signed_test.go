@@ -1,7 +1,10 @@
 package cast_test
 
 import (
+	"encoding/json"
+	"errors"
 	"math"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -269,3 +272,324 @@ func TestToSignedSliceInt64(t *testing.T) {
 		}
 	}
 }
+
+type level int8
+
+type port uint16
+
+type stringerLevel int8
+
+func (l stringerLevel) String() string {
+	return "level"
+}
+
+func TestToSignedNamed(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected level
+		err      bool
+	}{
+		{level(3), 3, false},
+		{stringerLevel(-4), -4, false},
+		{port(100), 100, false},
+		{port(1000), 0, true},
+		{"12", 12, false},
+		{uint64(math.MaxUint64), 0, true},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToSigned[level](test.input)
+		if test.err {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		}
+	}
+
+	i, err := cast.ToSigned[int64](uint64(math.MaxUint64))
+	assert.True(t, cast.IsOverflowError(err))
+	assert.Equal(t, int64(0), i)
+}
+
+func TestToSignedSliceNamed(t *testing.T) {
+	result, err := cast.ToSignedSlice[level]([]stringerLevel{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []level{1, 2}, result)
+
+	result, err = cast.ToSignedSlice[level]([]level{3})
+	assert.NoError(t, err)
+	assert.Equal(t, []level{3}, result)
+}
+
+func TestToSignedSliceFast(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected []int8
+		err      bool
+	}{
+		{[]int{1, -2}, []int8{1, -2}, false},
+		{[]int64{1, 300}, nil, true},
+		{[]float64{1.5, -2}, []int8{1, -2}, false},
+		{[]float64{math.NaN()}, nil, true},
+		{[]string{"1", "+2", "-3.5"}, []int8{1, 2, -3}, false},
+		{[]string{"1", "a"}, nil, true},
+		{[]json.Number{"1", "2e1"}, []int8{1, 20}, false},
+		{[]interface{}{1, "2", 3.0, level(4)}, []int8{1, 2, 3, 4}, false},
+		{[]interface{}{1, nil}, nil, true},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToSignedSlice[int8](test.input)
+		if test.err {
+			assert.Error(t, err)
+			assert.Nil(t, result)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		}
+	}
+
+	_, err := cast.ToSignedSlice[int8]([]string{"1", "a"})
+	assert.True(t, cast.IsSyntaxError(err))
+	assert.Equal(t, "[1]", cast.CastErrors(err)[0].Path)
+
+	result, err := cast.ToSignedSlice[int8]([]string{"1", "a", "3"}, cast.WithInvalidPolicy(cast.InvalidSkip))
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{1, 3}, result)
+
+	result, err = cast.ToSignedSlice[int8]([]interface{}{1, nil, 3}, cast.WithNilPolicy(cast.NilSkip))
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{1, 3}, result)
+
+	var report cast.SliceReport
+	result, err = cast.ToSignedSlice[int8]([]int{1, 300}, cast.WithClamp(), cast.WithReport(&report))
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{1, math.MaxInt8}, result)
+	assert.Equal(t, []int{1}, report.Clamped)
+}
+
+func TestToSignedOrMust(t *testing.T) {
+	assert.Equal(t, int8(5), cast.ToSignedOr[int8]("5", 1))
+	assert.Equal(t, int8(1), cast.ToSignedOr[int8]("500", 1))
+	assert.Equal(t, int8(127), cast.ToSignedOr[int8]("500", 1, cast.WithClamp()))
+	assert.Equal(t, []int{1, 2}, cast.ToSignedSliceOr([]string{"1", "2"}, []int{0}))
+	assert.Equal(t, []int{0}, cast.ToSignedSliceOr([]string{"1", "x"}, []int{0}))
+
+	assert.Equal(t, int64(5), cast.MustSigned[int64]("5"))
+	assert.Panics(t, func() { cast.MustSigned[int8](500) })
+	assert.Equal(t, []int{3}, cast.MustSignedSlice[int]([]float64{3}))
+	assert.Panics(t, func() { cast.MustSignedSlice[int]("x") })
+}
+
+func TestAppendSigned(t *testing.T) {
+	buf := make([]int8, 1, 8)
+	buf[0] = 9
+
+	result, err := cast.AppendSigned(buf, []string{"1", "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{9, 1, 2}, result)
+	assert.Equal(t, &buf[:1][0], &result[0])
+
+	result, err = cast.AppendSigned(result, []int16{3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{9, 1, 2, 3}, result)
+
+	result, err = cast.AppendSigned(result, []int8{4})
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{9, 1, 2, 3, 4}, result)
+
+	failed, err := cast.AppendSigned(result, []interface{}{5, "a"})
+	assert.True(t, cast.IsSyntaxError(err))
+	assert.Equal(t, result, failed)
+
+	failed, err = cast.AppendSigned(result, nil)
+	assert.True(t, cast.IsNilError(err))
+	assert.Equal(t, result, failed)
+
+	result, err = cast.AppendSigned(result[:0], []int{6, 300}, cast.WithInvalidPolicy(cast.InvalidSkip))
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{6}, result)
+
+	result, err = cast.AppendSigned[int8](nil, []int{})
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+type int64Provider struct{ n int64 }
+
+func (p int64Provider) Int64() (int64, error) { return p.n, nil }
+
+type uint8Provider struct{ n uint8 }
+
+func (p uint8Provider) Uint8() (uint8, error) { return p.n, nil }
+
+type float64Provider struct{ f float64 }
+
+func (p float64Provider) Float64() (float64, error) { return p.f, nil }
+
+func TestToSignedProviderFallback(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected int16
+		err      bool
+	}{
+		{int64Provider{-300}, -300, false},
+		{int64Provider{1 << 20}, 0, true},
+		{uint8Provider{200}, 200, false},
+		{float64Provider{12.7}, 12, false},
+		{float64Provider{math.NaN()}, 0, true},
+		{failingProvider{errors.New("failed")}, 0, true},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToSigned[int16](test.input)
+		if test.err {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		}
+	}
+
+	_, err := cast.ToSigned[int16](int64Provider{1 << 20})
+	assert.True(t, cast.IsOverflowError(err))
+}
+
+func TestToSignedNonFinite(t *testing.T) {
+	inputs := []interface{}{math.NaN(), math.Inf(1), math.Inf(-1), "NaN", "+Inf", float32(math.NaN()), ratio(math.Inf(1))}
+
+	for _, input := range inputs {
+		_, err := cast.ToSigned[int64](input)
+		assert.True(t, cast.IsNaNError(err))
+
+		_, err = cast.ToUnsigned[uint8](input)
+		assert.True(t, cast.IsNaNError(err))
+	}
+}
+
+func TestToSignedClamp(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected int8
+		clamped  bool
+		err      bool
+	}{
+		{100, 100, false, false},
+		{1000, math.MaxInt8, true, false},
+		{-1000, math.MinInt8, true, false},
+		{uint64(math.MaxUint64), math.MaxInt8, true, false},
+		{"-300", math.MinInt8, true, false},
+		{"1e10", math.MaxInt8, true, false},
+		{-1e10, math.MinInt8, true, false},
+		{"1e400", math.MaxInt8, true, false},
+		{"-1e400", math.MinInt8, true, false},
+		{math.NaN(), 0, false, true},
+		{"invalid", 0, false, true},
+	}
+
+	for _, test := range tests {
+		result, clamped, err := cast.ToSignedClamp[int8](test.input)
+		if test.err {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+			assert.Equal(t, test.clamped, clamped)
+		}
+	}
+
+	_, err := cast.ToSigned[int64]("1e400")
+	assert.True(t, cast.IsOverflowError(err))
+
+	i, err := cast.ToSigned[int16](1<<20, cast.WithClamp())
+	assert.NoError(t, err)
+	assert.Equal(t, int16(math.MaxInt16), i)
+
+	report := &cast.SliceReport{}
+	slice, err := cast.ToSignedSlice[int8]([]interface{}{1, 200, "-200", 3}, cast.WithClamp(), cast.WithReport(report))
+	assert.NoError(t, err)
+	assert.Equal(t, []int8{1, math.MaxInt8, math.MinInt8, 3}, slice)
+	assert.Equal(t, []int{1, 2}, report.Clamped)
+
+	assert.Equal(t, int8(math.MaxInt8), cast.NewCaster(500, cast.WithClamp()).Int8Safe(0))
+}
+
+func TestToSignedAllocs(t *testing.T) {
+	inputs := []interface{}{42, int8(-1), uint16(7), int64(1 << 30), 3.0, float32(2), "12345", "1.5", true, port(9)}
+
+	for _, input := range inputs {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = cast.ToSigned[int64](input)
+		})
+		assert.Zero(t, allocs, "%T %v", input, input)
+	}
+}
+
+func BenchmarkToSigned(b *testing.B) {
+	inputs := map[string]interface{}{
+		"int":     42,
+		"int64":   int64(1 << 30),
+		"float64": 3.0,
+		"string":  "12345",
+		"float":   "1.5",
+		"named":   port(9),
+	}
+
+	for name, input := range inputs {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = cast.ToSigned[int64](input)
+			}
+		})
+	}
+}
+
+func BenchmarkToSignedSlice(b *testing.B) {
+	ints := make([]int, 1000)
+	int16s := make([]int16, 1000)
+	strs := make([]string, 1000)
+	anys := make([]interface{}, 1000)
+	nums := make([]json.Number, 1000)
+	for i := range ints {
+		ints[i] = i
+		int16s[i] = int16(i)
+		strs[i] = strconv.Itoa(i)
+		anys[i] = i
+		nums[i] = json.Number(strs[i])
+	}
+
+	inputs := []struct {
+		name  string
+		input interface{}
+	}{
+		{"int", ints},
+		{"string", strs},
+		{"any", anys},
+		{"json.Number", nums},
+		{"reflect", int16s},
+	}
+
+	for _, input := range inputs {
+		b.Run(input.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = cast.ToSignedSlice[int64](input.input)
+			}
+		})
+	}
+}
+
+func BenchmarkAppendSigned(b *testing.B) {
+	ints := make([]int, 1000)
+	for i := range ints {
+		ints[i] = i
+	}
+
+	b.ReportAllocs()
+	var buf []int64
+	for i := 0; i < b.N; i++ {
+		buf, _ = cast.AppendSigned(buf[:0], ints)
+	}
+}
@@ -17,13 +17,41 @@ type StringSliceProvider interface {
 }
 
 // ToString converts an interface to a string. Returns an error if the conversion is not possible.
-func ToString(value interface{}) (string, error) {
-	value = indirect(value)
+func ToString(value interface{}, opts ...Option) (string, error) {
+	return toString(value, newOptions(opts))
+}
+
+func toString(value interface{}, o *options) (string, error) {
+	v, err := convertString(value, o)
+	if err == nil && o.validators != nil {
+		err = o.validate(v, value, "string")
+	}
+	if err != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+func convertString(value interface{}, o *options) (string, error) {
+	value, err := indirect(value, o)
+	if err != nil {
+		return "", newCastError("string", value, o, err)
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[string](value, "string", o); ok {
+		return v, err
+	}
+
 	switch val := value.(type) {
 	case nil:
-		return "", newNilError("string")
+		return nilResult[string](o, "string")
 	case StringProvider:
-		return val.String()
+		v, err := val.String()
+		if err != nil {
+			return "", newCastError("string", value, o, err)
+		}
+		return v, nil
 	case fmt.Stringer:
 		return val.String(), nil
 	case bool:
@@ -37,42 +65,109 @@ func ToString(value interface{}) (string, error) {
 	case string:
 		return val, nil
 	default:
-		return "", newTypeError("string")
+		if name, ok := enumName(val); ok {
+			return name, nil
+		}
+		if u, ok := underlying(val); ok {
+			return convertString(u, o)
+		}
+		return "", newUnsupportedError("string", value, o)
+	}
+}
+
+// ToStringOr converts an interface like ToString, returning fallback on error.
+func ToStringOr(value interface{}, fallback string, opts ...Option) string {
+	if v, err := ToString(value, opts...); err == nil {
+		return v
 	}
+	return fallback
+}
+
+// MustString converts an interface like ToString, panicking with the error on failure.
+func MustString(value interface{}, opts ...Option) string {
+	return must(ToString(value, opts...))
 }
 
 // ToStringSlice converts an interface to a slice of string. Returns an error if the conversion is not possible.
-func ToStringSlice(value interface{}) ([]string, error) {
-	value = indirect(value)
+// A []string value is returned as is and shares its backing array; use WithCopy to get a copy.
+func ToStringSlice(value interface{}, opts ...Option) ([]string, error) {
+	return toStringSlice(value, newOptions(opts))
+}
+
+func toStringSlice(value interface{}, o *options) ([]string, error) {
+	o.resetReport()
+	if v, ok := value.([]string); ok && v != nil && !o.copy && o.validators == nil {
+		return v, nil
+	}
+	return appendString(nil, value, o)
+}
+
+// ToStringSliceOr converts an interface like ToStringSlice, returning fallback on error.
+func ToStringSliceOr(value interface{}, fallback []string, opts ...Option) []string {
+	if v, err := ToStringSlice(value, opts...); err == nil {
+		return v
+	}
+	return fallback
+}
+
+// MustStringSlice converts an interface like ToStringSlice, panicking with the error on failure.
+func MustStringSlice(value interface{}, opts ...Option) []string {
+	return must(ToStringSlice(value, opts...))
+}
+
+// AppendString converts an interface like ToStringSlice and appends the elements to dst.
+// On failure dst is returned unchanged.
+func AppendString(dst []string, value interface{}, opts ...Option) ([]string, error) {
+	return appendString(dst, value, newOptions(opts))
+}
+
+func appendString(dst []string, value interface{}, o *options) ([]string, error) {
+	o.resetReport()
+	value, err := indirect(value, o)
+	if err != nil {
+		return dst, newCastError("[]string", value, o, err)
+	}
+
+	// Handle validated conversions element by element
+	if o.validators != nil {
+		return validateSlice(dst, value, "[]string", o, toString)
+	}
+
+	// Handle generic providers
+	if v, ok, err := provide[[]string](value, "[]string", o); ok {
+		if err != nil {
+			return dst, err
+		}
+		return append(grow(dst, len(v)), v...), nil
+	}
+
 	switch v := value.(type) {
 	case nil:
-		return nil, newNilError("[]string")
+		_, err := nilResult[[]string](o, "[]string")
+		return dst, err
 	case StringSliceProvider:
-		return v.StringSlice()
+		res, err := v.StringSlice()
+		if err != nil {
+			return dst, newCastError("[]string", value, o, err)
+		}
+		return append(grow(dst, len(res)), res...), nil
 	case []string:
-		return v, nil
+		return append(grow(dst, len(v)), v...), nil
 	}
 
-	// Handle slices or arrays of values
-	switch reflect.TypeOf(value).Kind() {
-	case reflect.Slice, reflect.Array:
-		arr := reflect.ValueOf(value)
-		res := make([]string, 0, arr.Len())
-		for i := 0; i < arr.Len(); i++ {
-			s, err := ToString(arr.Index(i).Interface())
-			if err != nil {
-				if IsNilError(err) {
-					return nil, newNilError("[]string")
-				} else if IsCastError(err) {
-					return nil, newTypeError("[]string")
-				} else {
-					return nil, fmt.Errorf("[]string: %w", err)
-				}
-			}
-			res = append(res, s)
+	// Handle the providers of other slices
+	if s, ok, err := provideSlice(value); ok {
+		if err != nil {
+			return dst, newCastError("[]string", value, o, err)
 		}
+		value = s
+	}
+
+	// Handle common slices without reflection
+	if res, ok := fastSlice(dst, value, o, stringElements); ok {
 		return res, nil
 	}
 
-	return nil, newTypeError("[]string")
+	// Handle slices or arrays of values
+	return convertSlice(dst, value, "[]string", o, toString)
 }
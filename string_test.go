@@ -57,3 +57,27 @@ func TestToStringSlice(t *testing.T) {
 		}
 	}
 }
+
+func TestToStringNamed(t *testing.T) {
+	result, err := cast.ToString(port(8080))
+	assert.NoError(t, err)
+	assert.Equal(t, "8080", result)
+
+	result, err = cast.ToString(stringerLevel(1))
+	assert.NoError(t, err)
+	assert.Equal(t, "level", result)
+}
+
+func TestAppendString(t *testing.T) {
+	result, err := cast.AppendString([]string{"a"}, []interface{}{1, true, 1.5})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "1", "true", "1.5"}, result)
+
+	result, err = cast.AppendString(result[:1], []string{"b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, result)
+
+	failed, err := cast.AppendString(result, 1)
+	assert.Error(t, err)
+	assert.Equal(t, result, failed)
+}
@@ -0,0 +1,70 @@
+package cast
+
+import (
+	"reflect"
+	"sync"
+)
+
+// As converts the value of a Caster to T like To, using the options of the caster
+// followed by the given options. Results of a cached caster are reused, except for
+// slices and maps, which could be modified by the caller.
+func As[T any](c Caster, opts ...Option) (T, error) {
+	if c, ok := c.(*caster); ok && len(opts) == 0 {
+		switch reflect.TypeFor[T]().Kind() {
+		case reflect.Slice, reflect.Map:
+		default:
+			return casterValue(*c, to[T], nil)
+		}
+	}
+	return to[T](c.Interface(), casterOptions(c).with(opts))
+}
+
+// casterOptions returns the options of a caster created by this package.
+func casterOptions(c Caster) *options {
+	if c, ok := c.(*caster); ok {
+		return c.o
+	}
+	return defaultOptions
+}
+
+// Value holds a value converted to T. The conversion runs once, on first use,
+// and its result is cached. A Value is safe for concurrent use.
+type Value[T any] struct {
+	v    interface{}
+	o    *options
+	once sync.Once
+	res  T
+	err  error
+}
+
+// NewValue creates a Value converting v to T with the given options.
+func NewValue[T any](v interface{}, opts ...Option) *Value[T] {
+	return &Value[T]{v: v, o: newOptions(opts)}
+}
+
+// ValueOf creates a Value converting the value of a Caster to T, using the options
+// of the caster followed by the given options.
+func ValueOf[T any](c Caster, opts ...Option) *Value[T] {
+	return &Value[T]{v: c.Interface(), o: casterOptions(c).with(opts)}
+}
+
+// Get returns the converted value.
+func (v *Value[T]) Get() (T, error) {
+	v.once.Do(func() {
+		v.res, v.err = to[T](v.v, v.o)
+	})
+	return v.res, v.err
+}
+
+// Or returns the converted value, or fallback if the conversion failed.
+func (v *Value[T]) Or(fallback T) T {
+	if res, err := v.Get(); err == nil {
+		return res
+	}
+	return fallback
+}
+
+// Must returns the converted value, panicking with the error if the conversion failed.
+func (v *Value[T]) Must() T {
+	return must(v.Get())
+}
@@ -0,0 +1,67 @@
+package cast_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uni-go/cast"
+)
+
+type countingProvider struct {
+	calls *atomic.Int32
+}
+
+func (p countingProvider) CastTo() (int, error) {
+	return int(p.calls.Add(1)), nil
+}
+
+func TestAs(t *testing.T) {
+	c := cast.NewCaster("300")
+
+	n, err := cast.As[int](c)
+	assert.NoError(t, err)
+	assert.Equal(t, 300, n)
+
+	_, err = cast.As[int8](c)
+	assert.True(t, cast.IsOverflowError(err))
+
+	n8, err := cast.As[int8](c, cast.WithClamp())
+	assert.NoError(t, err)
+	assert.Equal(t, int8(127), n8)
+
+	n8, err = cast.As[int8](cast.NewCaster("300", cast.WithClamp()))
+	assert.NoError(t, err)
+	assert.Equal(t, int8(127), n8)
+
+	d, err := cast.As[time.Duration](cast.NewCaster(int64(time.Minute)))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, d)
+}
+
+func TestValue(t *testing.T) {
+	p := countingProvider{new(atomic.Int32)}
+	v := cast.NewValue[int](p)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, 1, v.Must())
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), p.calls.Load())
+
+	invalid := cast.ValueOf[uint16](cast.NewCaster("-1"))
+	_, err := invalid.Get()
+	assert.True(t, cast.IsOverflowError(err))
+	assert.Equal(t, uint16(80), invalid.Or(80))
+	assert.Panics(t, func() { invalid.Must() })
+
+	ports := cast.NewValue[[]uint16]("8080", cast.WithNilPolicy(cast.NilAsZero))
+	assert.Nil(t, ports.Or(nil))
+}
@@ -1,6 +1,7 @@
 package cast_test
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -277,3 +278,104 @@ func TestToUnsignedSliceUint64(t *testing.T) {
 		}
 	}
 }
+
+func TestToUnsignedNamed(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected port
+		err      bool
+	}{
+		{port(8080), 8080, false},
+		{level(3), 3, false},
+		{level(-3), 0, true},
+		{"65535", 65535, false},
+		{65536, 0, true},
+		{uintptr(22), 22, false},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToUnsigned[port](test.input)
+		if test.err {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		}
+	}
+
+	result, err := cast.ToUnsignedSlice[port]([]level{1, 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []port{1, 2}, result)
+}
+
+func TestToUnsignedProviderFallback(t *testing.T) {
+	result, err := cast.ToUnsigned[uint16](int64Provider{300})
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(300), result)
+
+	_, err = cast.ToUnsigned[uint16](int64Provider{-1})
+	assert.True(t, cast.IsOverflowError(err))
+
+	result, err = cast.ToUnsigned[uint16](float64Provider{2.5})
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(2), result)
+
+	_, err = cast.ToUnsigned[uint16](failingProvider{errors.New("failed")})
+	assert.Error(t, err)
+}
+
+func TestToUnsignedClamp(t *testing.T) {
+	tests := []struct {
+		input    interface{}
+		expected uint8
+		clamped  bool
+	}{
+		{100, 100, false},
+		{1000, math.MaxUint8, true},
+		{-1, 0, true},
+		{"-5.5", 0, true},
+		{"70000", math.MaxUint8, true},
+	}
+
+	for _, test := range tests {
+		result, clamped, err := cast.ToUnsignedClamp[uint8](test.input)
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, result)
+		assert.Equal(t, test.clamped, clamped)
+	}
+
+	u, err := cast.ToUnsigned[uint64](-1, cast.WithClamp())
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), u)
+}
+
+func TestToUnsignedAllocs(t *testing.T) {
+	inputs := []interface{}{42, int8(1), uint16(7), int64(1 << 30), 3.0, float32(2), "12345", "1.5", true, port(9)}
+
+	for _, input := range inputs {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = cast.ToUnsigned[uint32](input)
+		})
+		assert.Zero(t, allocs, "%T %v", input, input)
+	}
+}
+
+func BenchmarkToUnsigned(b *testing.B) {
+	inputs := map[string]interface{}{
+		"int":     42,
+		"int64":   int64(1 << 30),
+		"float64": 3.0,
+		"string":  "12345",
+		"float":   "1.5",
+		"named":   port(9),
+	}
+
+	for name, input := range inputs {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = cast.ToUnsigned[uint32](input)
+			}
+		})
+	}
+}
@@ -3,88 +3,572 @@
 package cast
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"math/big"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 )
 
-type numeric interface {
-	~int | ~int8 | ~int16 | ~int32 | ~int64 |
-		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
-		~float32 | ~float64
-}
-
 // typeName returns the name of the type T as a string.
+// The name is static, so calling typeName does not allocate.
 func typeName[T any]() string {
-	var sample T
-	return reflect.TypeOf(sample).String()
+	return reflect.TypeFor[T]().String()
+}
+
+// must returns v, panicking with err if it is not nil.
+func must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// textOf returns the string representation of values implementing fmt.Stringer
+// or error, which other types are parsed from. Other values are not formatted,
+// as maps, slices and structs may contain cycles that fmt does not detect.
+func textOf(value any) (string, bool) {
+	switch v := value.(type) {
+	case fmt.Stringer:
+		return v.String(), true
+	case error:
+		return v.Error(), true
+	}
+	return "", false
 }
 
 // indirect returns the value, after dereferencing as many times
 // as necessary to reach the base type (or nil).
-func indirect(value any) any {
+// Typed nils (nil pointers, maps, slices, funcs and channels) are returned as nil.
+// Pointer cycles are reported with ErrCycle and chains longer than the
+// maximum depth with ErrDepth, along with the original value.
+func indirect(value any, o *options) (any, error) {
 	if value == nil {
-		return nil
+		return nil, nil
 	}
 
-	if t := reflect.TypeOf(value); t.Kind() != reflect.Pointer {
-		return value
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if reflect.ValueOf(value).IsNil() {
+			return nil, nil
+		}
+		return value, nil
+	case reflect.Pointer:
+	default:
+		return value, nil
 	}
 
+	// The slow value follows the chain at half speed, so both values
+	// point to the same location only if the chain is a cycle.
 	v := reflect.ValueOf(value)
-	for v.Kind() == reflect.Pointer && !v.IsNil() {
+	slow := v
+	for i := 0; (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) && !v.IsNil(); i++ {
+		if o.depth+i >= o.limit() {
+			return value, ErrDepth
+		}
+
 		v = v.Elem()
+		if i%2 == 1 {
+			slow = slow.Elem()
+		}
+
+		if v.Kind() == reflect.Pointer && slow.Kind() == reflect.Pointer &&
+			v.Type() == slow.Type() && v.Pointer() == slow.Pointer() && !v.IsNil() {
+			return value, ErrCycle
+		}
 	}
-	return v.Interface()
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if v.IsNil() {
+			return nil, nil
+		}
+	}
+	return v.Interface(), nil
 }
 
-// inRange checks if `i` can be safely converted to type `T`.
-func inRange[T numeric, I numeric](i I) (T, bool) {
-	var zero T
-	switch any(zero).(type) {
-	case int:
-		if int64(i) >= math.MinInt && int64(i) <= math.MaxInt {
-			return T(i), true
+// isNil reports whether the value resolves to nil.
+// Values that cannot be resolved are not considered nil.
+func isNil(value any, o *options) bool {
+	v, err := indirect(value, o)
+	return err == nil && v == nil
+}
+
+// grow returns dst with room for n more elements.
+// A nil dst yields an empty, non-nil slice.
+func grow[T any](dst []T, n int) []T {
+	if dst == nil {
+		return make([]T, 0, n)
+	}
+	return slices.Grow(dst, n)
+}
+
+// convertSlice converts each element of a slice or array using conv and appends it to dst.
+// Nil elements are handled according to the nil policy.
+// Invalid elements are handled according to the invalid policy; in collect mode
+// they are kept as zero values and all element errors are joined.
+// On failure dst is returned unchanged.
+func convertSlice[T any](dst []T, value any, title string, o *options, conv func(any, *options) (T, error)) ([]T, error) {
+	switch reflect.TypeOf(value).Kind() {
+	case reflect.Slice, reflect.Array:
+	default:
+		return dst, newUnsupportedError(title, value, o)
+	}
+
+	nested, err := o.enter()
+	if err != nil {
+		return dst, newCastError(title, value, o, err)
+	}
+
+	var errs []error
+	arr := reflect.ValueOf(value)
+	res := grow(dst, arr.Len())
+	for i := 0; i < arr.Len(); i++ {
+		item := arr.Index(i).Interface()
+		if o.nilPolicy == NilSkip && isNil(item, nested) {
+			continue
 		}
-	case int8:
-		if int64(i) >= math.MinInt8 && int64(i) <= math.MaxInt8 {
-			return T(i), true
+
+		var v T
+		var clamped bool
+		if o.clamp {
+			v, clamped, err = clampConvert(item, nested, conv)
+			if clamped {
+				o.report.addClamped(i)
+			}
+		} else {
+			v, err = conv(item, nested)
 		}
-	case int16:
-		if int64(i) >= math.MinInt16 && int64(i) <= math.MaxInt16 {
-			return T(i), true
+		if err != nil {
+			err = newElementError(title, value, i, err)
+			switch o.invalid {
+			case InvalidSkip:
+				o.report.addInvalid(i, err)
+				continue
+			case InvalidAsZero:
+				o.report.addInvalid(i, err)
+			case InvalidAsDefault:
+				fallback, ferr := conv(o.fallback, nested)
+				if ferr != nil {
+					return dst, newCastError(title, o.fallback, o, ferr)
+				}
+				o.report.addInvalid(i, err)
+				v = fallback
+			default:
+				if !o.collect {
+					return dst, err
+				}
+				errs = append(errs, err)
+			}
 		}
-	case int32:
-		if int64(i) >= math.MinInt32 && int64(i) <= math.MaxInt32 {
-			return T(i), true
+		res = append(res, v)
+	}
+	return res, errors.Join(errs...)
+}
+
+// underlying converts a value of a named basic type (e.g. `type Level int8`)
+// to the value of its underlying kind (bool, int64, uint64, float64 or string).
+func underlying(value any) (any, bool) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		return v.String(), true
+	}
+	return value, false
+}
+
+// isInteger reports whether s is a decimal integer with an optional sign.
+// It allows parsing integers without the allocation of a failed strconv.ParseInt.
+func isInteger(s string) bool {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	if len(s) == 0 {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
 		}
-	case int64:
-		return T(i), true
-	case uint:
-		if i >= 0 && uint64(i) <= math.MaxUint {
-			return T(i), true
+	}
+	return true
+}
+
+// signedToInteger converts an integer to the integer type T.
+func signedToInteger[T Signed | Unsigned](n int64, value any, o *options) (T, error) {
+	if v, ok := inRange[T](n); ok {
+		return v, nil
+	}
+	return overflow[T](n < 0, value, o)
+}
+
+// unsignedToInteger converts an unsigned integer to the integer type T.
+func unsignedToInteger[T Signed | Unsigned](n uint64, value any, o *options) (T, error) {
+	if v, ok := inRange[T](n); ok {
+		return v, nil
+	}
+	return overflow[T](false, value, o)
+}
+
+// floatToInteger converts a float to the integer type T.
+// NaN and infinities are always rejected, since their conversion is implementation-defined.
+func floatToInteger[T Signed | Unsigned](f float64, value any, o *options) (T, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, newNaNError(typeName[T](), value, o)
+	}
+	if v, ok := inRange[T](f); ok {
+		return v, nil
+	}
+	return overflow[T](f < 0, value, o)
+}
+
+// parseInteger parses a string as the integer type T.
+// Decimal integers are parsed exactly, other numbers are parsed as floats.
+func parseInteger[T Signed | Unsigned](s string, value any, o *options) (T, error) {
+	if isInteger(s) {
+		if s[0] != '-' {
+			if u, err := strconv.ParseUint(strings.TrimPrefix(s, "+"), 10, 64); err == nil {
+				return unsignedToInteger[T](u, value, o)
+			}
+		} else if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return signedToInteger[T](i, value, o)
 		}
-	case uint8:
-		if i >= 0 && uint64(i) <= math.MaxUint8 {
-			return T(i), true
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err == nil {
+		return floatToInteger[T](f, value, o)
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return overflow[T](f < 0, value, o)
+	}
+	return 0, newSyntaxError(typeName[T](), value, o)
+}
+
+// floatToFloat converts a float to the float type T.
+// NaN and infinities are kept unless rejected by the options,
+// and values that are not represented exactly are rejected if precision is checked.
+func floatToFloat[T Float](f float64, value any, o *options) (T, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		if o.rejectNonFinite {
+			return 0, newNaNError(typeName[T](), value, o)
 		}
-	case uint16:
-		if i >= 0 && uint64(i) <= math.MaxUint16 {
-			return T(i), true
+		return T(f), nil
+	}
+	if v, ok := inRange[T](f); ok {
+		if o.precision && float64(v) != f {
+			return 0, newPrecisionError(typeName[T](), value, o)
+		}
+		return v, nil
+	}
+	return overflow[T](f < 0, value, o)
+}
+
+// signedToFloat converts an integer to the float type T.
+// Integers that are not represented exactly are rejected if precision is checked.
+func signedToFloat[T Float](n int64, value any, o *options) (T, error) {
+	v := T(n)
+	if o.precision {
+		if f := float64(v); f >= 0x1p63 || int64(f) != n {
+			return 0, newPrecisionError(typeName[T](), value, o)
+		}
+	}
+	return v, nil
+}
+
+// unsignedToFloat converts an unsigned integer to the float type T.
+// Integers that are not represented exactly are rejected if precision is checked.
+func unsignedToFloat[T Float](n uint64, value any, o *options) (T, error) {
+	v := T(n)
+	if o.precision {
+		if f := float64(v); f >= 0x1p64 || uint64(f) != n {
+			return 0, newPrecisionError(typeName[T](), value, o)
+		}
+	}
+	return v, nil
+}
+
+// parseFloat parses a string as the float type T.
+// Integers are parsed exactly if precision is checked.
+func parseFloat[T Float](s string, value any, o *options) (T, error) {
+	if o.precision && isInteger(s) {
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return signedToFloat[T](i, value, o)
+		}
+		if u, err := strconv.ParseUint(strings.TrimPrefix(s, "+"), 10, 64); err == nil {
+			return unsignedToFloat[T](u, value, o)
+		}
+
+		// Larger integers are exact only if the parsed float equals them
+		if n, ok := new(big.Int).SetString(s, 10); ok {
+			if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsInf(float64(T(f)), 0) {
+				if x, _ := big.NewFloat(float64(T(f))).Int(nil); x.Cmp(n) != 0 {
+					return 0, newPrecisionError(typeName[T](), value, o)
+				}
+			}
+		}
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err == nil {
+		return floatToFloat[T](f, value, o)
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return overflow[T](f < 0, value, o)
+	}
+	return 0, newSyntaxError(typeName[T](), value, o)
+}
+
+// number holds the value of a numeric provider, kept at full width.
+type number struct {
+	kind reflect.Kind // reflect.Int64, reflect.Uint64 or reflect.Float64
+	i    int64
+	u    uint64
+	f    float64
+}
+
+// provideNumber calls the first numeric provider implemented by value, trying
+// signed, unsigned and float providers from the widest to the narrowest.
+// It reports false if value implements no numeric provider.
+func provideNumber(value any) (number, bool, error) {
+	var (
+		n   number
+		err error
+	)
+	switch p := value.(type) {
+	case Int64Provider:
+		v, e := p.Int64()
+		n, err = number{kind: reflect.Int64, i: v}, e
+	case IntProvider:
+		v, e := p.Int()
+		n, err = number{kind: reflect.Int64, i: int64(v)}, e
+	case Int32Provider:
+		v, e := p.Int32()
+		n, err = number{kind: reflect.Int64, i: int64(v)}, e
+	case Int16Provider:
+		v, e := p.Int16()
+		n, err = number{kind: reflect.Int64, i: int64(v)}, e
+	case Int8Provider:
+		v, e := p.Int8()
+		n, err = number{kind: reflect.Int64, i: int64(v)}, e
+	case Uint64Provider:
+		v, e := p.Uint64()
+		n, err = number{kind: reflect.Uint64, u: v}, e
+	case UintProvider:
+		v, e := p.Uint()
+		n, err = number{kind: reflect.Uint64, u: uint64(v)}, e
+	case Uint32Provider:
+		v, e := p.Uint32()
+		n, err = number{kind: reflect.Uint64, u: uint64(v)}, e
+	case Uint16Provider:
+		v, e := p.Uint16()
+		n, err = number{kind: reflect.Uint64, u: uint64(v)}, e
+	case Uint8Provider:
+		v, e := p.Uint8()
+		n, err = number{kind: reflect.Uint64, u: uint64(v)}, e
+	case Float64Provider:
+		v, e := p.Float64()
+		n, err = number{kind: reflect.Float64, f: v}, e
+	case Float32Provider:
+		v, e := p.Float32()
+		n, err = number{kind: reflect.Float64, f: float64(v)}, e
+	default:
+		return n, false, nil
+	}
+	return n, true, err
+}
+
+// provideSlice calls the first slice provider implemented by value, trying typed
+// slice providers before SliceProvider, and returns the provided slice.
+// It reports false if value implements no slice provider.
+func provideSlice(value any) (any, bool, error) {
+	switch p := value.(type) {
+	case Int64SliceProvider:
+		s, err := p.Int64Slice()
+		return s, true, err
+	case IntSliceProvider:
+		s, err := p.IntSlice()
+		return s, true, err
+	case Int32SliceProvider:
+		s, err := p.Int32Slice()
+		return s, true, err
+	case Int16SliceProvider:
+		s, err := p.Int16Slice()
+		return s, true, err
+	case Int8SliceProvider:
+		s, err := p.Int8Slice()
+		return s, true, err
+	case Uint64SliceProvider:
+		s, err := p.Uint64Slice()
+		return s, true, err
+	case UintSliceProvider:
+		s, err := p.UintSlice()
+		return s, true, err
+	case Uint32SliceProvider:
+		s, err := p.Uint32Slice()
+		return s, true, err
+	case Uint16SliceProvider:
+		s, err := p.Uint16Slice()
+		return s, true, err
+	case Uint8SliceProvider:
+		s, err := p.Uint8Slice()
+		return s, true, err
+	case Float64SliceProvider:
+		s, err := p.Float64Slice()
+		return s, true, err
+	case Float32SliceProvider:
+		s, err := p.Float32Slice()
+		return s, true, err
+	case BoolSliceProvider:
+		s, err := p.BoolSlice()
+		return s, true, err
+	case StringSliceProvider:
+		s, err := p.StringSlice()
+		return s, true, err
+	case SliceProvider:
+		s, err := p.Slice()
+		return s, true, err
+	}
+	return nil, false, nil
+}
+
+// numberToInteger converts a provided number to the integer type T.
+func numberToInteger[T Signed | Unsigned](n number, value any, o *options) (T, error) {
+	switch n.kind {
+	case reflect.Int64:
+		return signedToInteger[T](n.i, value, o)
+	case reflect.Uint64:
+		return unsignedToInteger[T](n.u, value, o)
+	default:
+		return floatToInteger[T](n.f, value, o)
+	}
+}
+
+// numberToFloat converts a provided number to the float type T.
+func numberToFloat[T Float](n number, value any, o *options) (T, error) {
+	switch n.kind {
+	case reflect.Int64:
+		return signedToFloat[T](n.i, value, o)
+	case reflect.Uint64:
+		return unsignedToFloat[T](n.u, value, o)
+	default:
+		return floatToFloat[T](n.f, value, o)
+	}
+}
+
+// bounds returns the minimum and maximum values of type T.
+func bounds[T numeric]() (T, T) {
+	t := reflect.TypeFor[T]()
+	bits := t.Bits()
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var lo, hi int64 = -1 << (bits - 1), 1<<(bits-1) - 1
+		return T(lo), T(hi)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var hi uint64 = math.MaxUint64 >> (64 - bits)
+		return 0, T(hi)
+	case reflect.Float32:
+		var lo, hi float64 = -math.MaxFloat32, math.MaxFloat32
+		return T(lo), T(hi)
+	default:
+		var lo, hi float64 = -math.MaxFloat64, math.MaxFloat64
+		return T(lo), T(hi)
+	}
+}
+
+// overflow returns the bound of T nearest to an out of range value in clamp mode,
+// or an overflow error otherwise.
+func overflow[T numeric](below bool, value any, o *options) (T, error) {
+	if o.clamp {
+		lo, hi := bounds[T]()
+		if below {
+			return lo, nil
 		}
-	case uint32:
-		if i >= 0 && uint64(i) <= math.MaxUint32 {
+		return hi, nil
+	}
+	return 0, newOverflowError(typeName[T](), value, o)
+}
+
+// clampConvert converts a value in clamp mode and reports whether it was clamped.
+func clampConvert[T any](value any, o *options, conv func(any, *options) (T, error)) (T, bool, error) {
+	strict := *o
+	strict.clamp = false
+	v, err := conv(value, &strict)
+	if err == nil || !IsOverflowError(err) {
+		return v, false, err
+	}
+
+	clamped := *o
+	clamped.clamp = true
+	v, err = conv(value, &clamped)
+	return v, err == nil, err
+}
+
+// inRange checks if `i` can be safely converted to type `T`.
+// Both types are classified by their underlying kind, so named types are supported.
+func inRange[T numeric, I numeric](i I) (T, bool) {
+	var zero T
+	target := reflect.TypeFor[T]()
+	bits := target.Bits()
+
+	switch reflect.TypeFor[I]().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := int64(i)
+		switch target.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if bits == 64 || (n >= -1<<(bits-1) && n < 1<<(bits-1)) {
+				return T(i), true
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if n >= 0 && (bits == 64 || uint64(n) < 1<<bits) {
+				return T(i), true
+			}
+		case reflect.Float32, reflect.Float64:
 			return T(i), true
 		}
-	case uint64:
-		if i >= 0 {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := uint64(i)
+		switch target.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if u < 1<<(bits-1) {
+				return T(i), true
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if bits == 64 || u < 1<<bits {
+				return T(i), true
+			}
+		case reflect.Float32, reflect.Float64:
 			return T(i), true
 		}
-	case float32:
-		if float64(i) >= -math.MaxFloat32 && float64(i) <= math.MaxFloat32 {
+	case reflect.Float32, reflect.Float64:
+		f := float64(i)
+		switch target.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if t := math.Trunc(f); t >= -math.Ldexp(1, bits-1) && t < math.Ldexp(1, bits-1) {
+				return T(i), true
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			if t := math.Trunc(f); t >= 0 && t < math.Ldexp(1, bits) {
+				return T(i), true
+			}
+		case reflect.Float32:
+			if f >= -math.MaxFloat32 && f <= math.MaxFloat32 {
+				return T(i), true
+			}
+		case reflect.Float64:
 			return T(i), true
 		}
-	case float64:
-		return T(i), true
 	}
 	return zero, false
 }
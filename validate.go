@@ -0,0 +1,196 @@
+package cast
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+)
+
+// validator checks a converted value and returns a *ValidationError if it is rejected.
+type validator func(v interface{}, o *options) error
+
+// ValidationError represents a converted value rejected by a validator.
+type ValidationError struct {
+	Rule  string
+	Value interface{}
+	Msg   string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Msg
+}
+
+// Unwrap returns ErrValidation, so IsValidationError reports true.
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
+
+func newValidationError(rule string, v interface{}, o *options, format string, args ...interface{}) error {
+	e := &ValidationError{Rule: rule, Msg: fmt.Sprintf(format, args...)}
+	if !o.redact {
+		e.Value = v
+	}
+	return e
+}
+
+// validate checks the converted value v of value against the validators of the options.
+func (o *options) validate(v, value interface{}, title string) error {
+	for _, check := range o.validators {
+		if err := check(v, o); err != nil {
+			return newCastError(title, value, o, err)
+		}
+	}
+	return nil
+}
+
+// Min rejects converted numbers less than min, and values that are not numbers.
+// For slice conversions every element is checked.
+func Min[N numeric](min N) Option {
+	return addValidator(func(v interface{}, o *options) error {
+		if c, ok := compareNumbers(v, min); !ok || c < 0 {
+			return newValidationError("min", v, o, "must be at least %v", min)
+		}
+		return nil
+	})
+}
+
+// Max rejects converted numbers greater than max, and values that are not numbers.
+// For slice conversions every element is checked.
+func Max[N numeric](max N) Option {
+	return addValidator(func(v interface{}, o *options) error {
+		if c, ok := compareNumbers(v, max); !ok || c > 0 {
+			return newValidationError("max", v, o, "must be at most %v", max)
+		}
+		return nil
+	})
+}
+
+// OneOf rejects converted values that are not equal to one of the given values.
+// The converted value is compared after converting it to the type of the values.
+// For slice conversions every element is checked.
+func OneOf[T comparable](values ...T) Option {
+	return addValidator(func(v interface{}, o *options) error {
+		if t, err := To[T](v); err != nil || !slices.Contains(values, t) {
+			return newValidationError("oneof", v, o, "must be one of %v", values)
+		}
+		return nil
+	})
+}
+
+// Match rejects converted values whose string representation does not match re.
+// For slice conversions every element is checked.
+func Match(re *regexp.Regexp) Option {
+	return addValidator(func(v interface{}, o *options) error {
+		if s, err := ToString(v); err != nil || !re.MatchString(s) {
+			return newValidationError("match", v, o, "must match %s", re)
+		}
+		return nil
+	})
+}
+
+// NotEmpty rejects empty strings and, for slice conversions, empty results
+// and empty string elements.
+func NotEmpty() Option {
+	add := addValidator(func(v interface{}, o *options) error {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.String && rv.Len() == 0 {
+			return newValidationError("notempty", v, o, "must not be empty")
+		}
+		return nil
+	})
+	return func(o *options) {
+		add(o)
+		o.notEmpty = true
+	}
+}
+
+// validateSlice converts a slice element by element with conv, so every element is
+// validated, and rejects empty results if NotEmpty is given.
+func validateSlice[T any](dst []T, value interface{}, title string, o *options, conv func(interface{}, *options) (T, error)) ([]T, error) {
+	if value == nil {
+		_, err := nilResult[[]T](o, title)
+		return dst, err
+	}
+
+	if v, ok, err := provide[[]T](value, title, o); ok {
+		if err != nil {
+			return dst, err
+		}
+		value = v
+	} else if s, ok, err := provideSlice(value); ok {
+		if err != nil {
+			return dst, newCastError(title, value, o, err)
+		}
+		value = s
+	}
+
+	res, err := convertSlice(dst, value, title, o, conv)
+	if err == nil && o.notEmpty && len(res) == len(dst) {
+		return dst, newCastError(title, value, o, newValidationError("notempty", value, o, "must not be empty"))
+	}
+	return res, err
+}
+
+// addValidator returns an option adding a validator.
+// The validators are copied, so options sharing a base never share them.
+func addValidator(check validator) Option {
+	return func(o *options) {
+		o.validators = append(slices.Clip(o.validators), check)
+	}
+}
+
+// compareNumbers compares the numbers a and b, which may be of different numeric types.
+// It reports false if a is not a number.
+func compareNumbers[N numeric](a interface{}, b N) (int, bool) {
+	x, ok := numberOf(reflect.ValueOf(a))
+	if !ok || math.IsNaN(x.f) {
+		return 0, false
+	}
+	y, _ := numberOf(reflect.ValueOf(b))
+
+	switch {
+	case x.kind == reflect.Float64 || y.kind == reflect.Float64:
+		return cmp.Compare(x.float(), y.float()), true
+	case x.kind == reflect.Int64 && y.kind == reflect.Int64:
+		return cmp.Compare(x.i, y.i), true
+	case x.kind == reflect.Uint64 && y.kind == reflect.Uint64:
+		return cmp.Compare(x.u, y.u), true
+	case x.kind == reflect.Int64:
+		if x.i < 0 {
+			return -1, true
+		}
+		return cmp.Compare(uint64(x.i), y.u), true
+	default:
+		if y.i < 0 {
+			return 1, true
+		}
+		return cmp.Compare(x.u, uint64(y.i)), true
+	}
+}
+
+// numberOf returns the number held by a numeric reflect value.
+func numberOf(v reflect.Value) (number, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{kind: reflect.Int64, i: v.Int()}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{kind: reflect.Uint64, u: v.Uint()}, true
+	case reflect.Float32, reflect.Float64:
+		return number{kind: reflect.Float64, f: v.Float()}, true
+	}
+	return number{}, false
+}
+
+// float returns the number as a float64.
+func (n number) float() float64 {
+	switch n.kind {
+	case reflect.Int64:
+		return float64(n.i)
+	case reflect.Uint64:
+		return float64(n.u)
+	default:
+		return n.f
+	}
+}
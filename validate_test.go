@@ -0,0 +1,167 @@
+package cast_test
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uni-go/cast"
+)
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		input interface{}
+		opts  []cast.Option
+		err   bool
+	}{
+		{"80", []cast.Option{cast.Min(1), cast.Max(65535)}, false},
+		{"0", []cast.Option{cast.Min(1), cast.Max(65535)}, true},
+		{"70000", []cast.Option{cast.Min(1), cast.Max(65535)}, true},
+		{"-1", []cast.Option{cast.Min(uint(0))}, true},
+		{"1.5", []cast.Option{cast.Max(1.5)}, false},
+		{"2", []cast.Option{cast.OneOf(1, 2, 3)}, false},
+		{"4", []cast.Option{cast.OneOf(1, 2, 3)}, true},
+		{"2", []cast.Option{cast.OneOf("1", "2")}, false},
+		{"42", []cast.Option{cast.Match(regexp.MustCompile(`^\d{2}$`))}, false},
+		{"420", []cast.Option{cast.Match(regexp.MustCompile(`^\d{2}$`))}, true},
+		{"1", []cast.Option{cast.NotEmpty()}, false},
+	}
+
+	for _, test := range tests {
+		result, err := cast.ToSigned[int](test.input, test.opts...)
+		if test.err {
+			assert.True(t, cast.IsValidationError(err), "%v %v", test.input, err)
+			assert.Zero(t, result)
+		} else {
+			assert.NoError(t, err, test.input)
+		}
+	}
+
+	_, err := cast.ToString("", cast.NotEmpty())
+	assert.True(t, cast.IsValidationError(err))
+
+	_, err = cast.ToFloat[float64](math.NaN(), cast.Min(0))
+	assert.True(t, cast.IsValidationError(err))
+
+	_, err = cast.ToSigned[int]("x", cast.Min(1))
+	assert.True(t, cast.IsSyntaxError(err))
+	assert.False(t, cast.IsValidationError(err))
+}
+
+func TestValidationError(t *testing.T) {
+	_, err := cast.ToSigned[int]("0", cast.Min(1))
+	assert.EqualError(t, err, `cannot cast string "0" to int: must be at least 1`)
+
+	var valErr *cast.ValidationError
+	assert.True(t, errors.As(err, &valErr))
+	assert.Equal(t, "min", valErr.Rule)
+	assert.Equal(t, 0, valErr.Value)
+
+	_, err = cast.ToSigned[int]("0", cast.Min(1), cast.WithRedactedValues())
+	assert.True(t, errors.As(err, &valErr))
+	assert.Nil(t, valErr.Value)
+	assert.NotContains(t, err.Error(), `"0"`)
+}
+
+func TestValidateSlice(t *testing.T) {
+	result, err := cast.ToSignedSlice[int]([]string{"1", "2"}, cast.Min(1))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, result)
+
+	_, err = cast.ToSignedSlice[int]([]int{1, 0}, cast.Min(1))
+	assert.True(t, cast.IsValidationError(err))
+	assert.Equal(t, "[1]", cast.CastErrors(err)[0].Path)
+
+	result, err = cast.ToSignedSlice[int]([]int{1, 0, 3}, cast.Min(1), cast.WithInvalidPolicy(cast.InvalidSkip))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, result)
+
+	_, err = cast.ToStringSlice([]string{}, cast.NotEmpty())
+	assert.True(t, cast.IsValidationError(err))
+
+	_, err = cast.ToStringSlice([]string{"a", ""}, cast.NotEmpty())
+	assert.True(t, cast.IsValidationError(err))
+
+	_, err = cast.To[[]uint8]([]uint8{1, 9}, cast.Max(5))
+	assert.True(t, cast.IsValidationError(err))
+
+	_, err = cast.ToEnumSlice[status]([]string{"active", "disabled"}, cast.OneOf(statusActive))
+	assert.True(t, cast.IsValidationError(err))
+}
+
+func TestCasterValidators(t *testing.T) {
+	c := cast.NewCaster("8080")
+
+	port, err := c.IntWith(cast.Min(1), cast.Max(65535))
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, port)
+
+	_, err = c.IntWith(cast.Max(1024))
+	assert.True(t, cast.IsValidationError(err))
+
+	limited := cast.NewCaster("8080", cast.Max(1024))
+	assert.Equal(t, 80, limited.IntSafe(80))
+	assert.Panics(t, func() { limited.MustInt() })
+
+	cached := cast.NewCachedCaster("8080")
+	_, err = cached.IntWith(cast.Max(1024))
+	assert.True(t, cast.IsValidationError(err))
+	assert.Equal(t, 8080, cached.IntSafe(80))
+
+	n, err := cast.ToSigned[int](c)
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, n)
+}
+
+func TestValidateOnce(t *testing.T) {
+	type port uint16
+
+	tests := []struct {
+		name string
+		conv func(opt cast.Option) error
+	}{
+		{"To", func(opt cast.Option) error { _, err := cast.To[int]("5", opt); return err }},
+		{"To named", func(opt cast.Option) error { _, err := cast.To[port]("80", opt); return err }},
+		{"To duration", func(opt cast.Option) error { _, err := cast.To[time.Duration]("1s", opt); return err }},
+		{"ToSigned", func(opt cast.Option) error { _, err := cast.ToSigned[int]("5", opt); return err }},
+		{"ToEnum", func(opt cast.Option) error { _, err := cast.ToEnum[status]("active", opt); return err }},
+		{"As", func(opt cast.Option) error { _, err := cast.As[int](cast.NewCaster("5"), opt); return err }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			calls := 0
+			err := test.conv(cast.WithCheck(func(interface{}) error {
+				calls++
+				return nil
+			}))
+			assert.NoError(t, err)
+			assert.Equal(t, 1, calls)
+		})
+	}
+}
+
+func TestValidateInterfaceSlice(t *testing.T) {
+	_, err := cast.ToSlice([]int{}, cast.NotEmpty())
+	assert.True(t, cast.IsValidationError(err))
+
+	_, err = cast.ToSlice([]int{2, 0}, cast.Min(1))
+	assert.True(t, cast.IsValidationError(err))
+	assert.Equal(t, "[1]", cast.CastErrors(err)[0].Path)
+
+	_, err = cast.NewCaster([]int{0}).SliceWith(cast.Min(1))
+	assert.True(t, cast.IsValidationError(err))
+
+	_, err = cast.To[[]interface{}]([]int{0}, cast.Min(1))
+	assert.True(t, cast.IsValidationError(err))
+
+	_, err = cast.To[[]interface{}]([]interface{}{0}, cast.Min(1))
+	assert.True(t, cast.IsValidationError(err))
+
+	result, err := cast.ToSlice([]int{1, 2}, cast.Min(1))
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{1, 2}, result)
+}